@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	"log"
 	"net/http"
 	"os"
@@ -14,11 +15,15 @@ import (
 	"shopping-mall/config"
 	"shopping-mall/internal/domain/point"
 	httpHandler "shopping-mall/internal/handler/http"
+	"shopping-mall/internal/infrastructure/auth"
 	"shopping-mall/internal/infrastructure/cache"
 	"shopping-mall/internal/infrastructure/database"
 	"shopping-mall/internal/infrastructure/logger"
+	"shopping-mall/internal/infrastructure/webhook"
 	"shopping-mall/internal/repository/mysql"
 	"shopping-mall/internal/repository/redis"
+	idempotencyUseCase "shopping-mall/internal/usecase/idempotency"
+	orderUseCase "shopping-mall/internal/usecase/order"
 	pointUseCase "shopping-mall/internal/usecase/point"
 )
 
@@ -41,7 +46,7 @@ func main() {
 		User:     cfg.MySQL.User,
 		Password: cfg.MySQL.Password,
 		Database: cfg.MySQL.Database,
-	}, migrationsDir)
+	}, migrationsDir, cfg.MySQL.AutoMigrate)
 	if err != nil {
 		zapLogger.Fatal("Failed to connect to MySQL", zap.Error(err))
 	}
@@ -49,6 +54,25 @@ func main() {
 	
 	zapLogger.Info("MySQL connected and initialized successfully")
 	
+	// MySQL 읽기 복제본 연결 (선택사항, 조회 전용 쿼리에 사용)
+	var replicaDB *sql.DB
+	if cfg.MySQL.ReplicaHost != "" {
+		replicaDB, err = database.NewMySQL(database.Config{
+			Host:     cfg.MySQL.ReplicaHost,
+			Port:     cfg.MySQL.ReplicaPort,
+			User:     cfg.MySQL.User,
+			Password: cfg.MySQL.Password,
+			Database: cfg.MySQL.Database,
+		})
+		if err != nil {
+			zapLogger.Warn("Failed to connect to MySQL replica, reading from primary", zap.Error(err))
+			replicaDB = nil
+		} else {
+			defer replicaDB.Close()
+			zapLogger.Info("MySQL read replica connected")
+		}
+	}
+
 	// Redis 연결
 	redisClient, err := cache.NewRedis(cache.Config{
 		Host:     cfg.Redis.Host,
@@ -62,39 +86,141 @@ func main() {
 	}
 	
 	// Repository 초기화
-	tm := mysql.NewTransactionManager(db)
+	retryPolicy := mysql.DefaultRetryPolicy()
+	retryPolicy.MaxRetries = cfg.MySQL.TxMaxRetries
+	isolation, err := mysql.ParseIsolationLevel(cfg.MySQL.TxIsolation)
+	if err != nil {
+		zapLogger.Fatal("Invalid transaction isolation level", zap.Error(err))
+	}
+	tm := mysql.NewTransactionManager(db).
+		WithRetryPolicy(retryPolicy).
+		WithTxOptions(&sql.TxOptions{Isolation: isolation}).
+		WithLogger(zapLogger)
+	if replicaDB != nil {
+		tm.WithReadReplica(replicaDB)
+	}
 	pointRepo := mysql.NewPointRepository(tm)
+	idempotencyRepo := mysql.NewIdempotencyRepository(tm)
+	orderRepo := mysql.NewOrderRepository(tm)
 	var pointCache *redis.PointCache
 	if redisClient != nil {
 		pointCache = redis.NewPointCache(redisClient)
 	}
 	
 	// Policy 초기화
-	policy := point.NewDefaultPolicy()
+	policy, err := cfg.PointPolicy()
+	if err != nil {
+		zapLogger.Fatal("Invalid point policy", zap.Error(err))
+	}
 	
 	// UseCase 초기화
-	queryUseCase := pointUseCase.NewQueryPointsUseCase(pointRepo, pointCache)
-	useUseCase := pointUseCase.NewUsePointsUseCase(pointRepo, tm, policy)
-	earnUseCase := pointUseCase.NewEarnPointsUseCase(pointRepo, tm, policy)
-	refundUseCase := pointUseCase.NewRefundPointsUseCase(pointRepo, tm)
+	queryUseCase := pointUseCase.NewQueryPointsUseCase(pointRepo, policy, pointCache)
+	useUseCase := pointUseCase.NewUsePointsUseCase(pointRepo, tm, policy, pointCache)
+	earnUseCase := pointUseCase.NewEarnPointsUseCase(pointRepo, tm, policy, pointCache)
+	refundUseCase := pointUseCase.NewRefundPointsUseCase(pointRepo, tm, policy, pointCache)
+	reserveUseCase := pointUseCase.NewReservePointsUseCase(pointRepo, tm, policy, pointCache)
+	reconcileUseCase := pointUseCase.NewReconcilePointsUseCase(pointRepo, tm, policy, pointCache)
+	adminAdjustUseCase := pointUseCase.NewAdminAdjustPointsUseCase(pointRepo, tm, policy, pointCache)
+	approvalUseCase := pointUseCase.NewAdjustmentApprovalUseCase(pointRepo, tm, policy, adminAdjustUseCase)
+	campaignUseCase := pointUseCase.NewCampaignUseCase(pointRepo)
+	webhookPolicy := point.DefaultDispatchPolicy()
+	webhookPolicy.MaxAttempts = cfg.Webhook.MaxAttempts
+	webhookUseCase := pointUseCase.NewWebhookUseCase(pointRepo, webhook.NewSender(time.Duration(cfg.Webhook.TimeoutSeconds)*time.Second), webhookPolicy)
+	idempotentUseCase := idempotencyUseCase.NewExecuteUseCase(idempotencyRepo, tm, time.Duration(cfg.Idempotency.TTLHours)*time.Hour)
+	transitionUseCase := orderUseCase.NewTransitionUseCase(orderRepo, pointRepo, tm, useUseCase, earnUseCase, refundUseCase)
 	
 	// Handler 초기화
-	pointHandler := httpHandler.NewPointHandler(queryUseCase, useUseCase, earnUseCase)
-	orderHandler := httpHandler.NewOrderHandler(useUseCase, earnUseCase, refundUseCase)
+	pointHandler := httpHandler.NewPointHandler(queryUseCase, useUseCase, earnUseCase, idempotentUseCase)
+	orderHandler := httpHandler.NewOrderHandler(transitionUseCase, idempotentUseCase)
+	reservationHandler := httpHandler.NewReservationHandler(reserveUseCase, idempotentUseCase)
+	reconciliationHandler := httpHandler.NewReconciliationHandler(reconcileUseCase)
+	adminPointHandler := httpHandler.NewAdminPointHandler(approvalUseCase, idempotentUseCase)
+	campaignHandler := httpHandler.NewCampaignHandler(campaignUseCase)
+	webhookHandler := httpHandler.NewWebhookHandler(webhookUseCase)
 	
 	// Router 설정
 	router := mux.NewRouter()
 	api := router.PathPrefix("/api/v1").Subrouter()
 	
+	// API 인증 초기화
+	verifier, err := auth.NewVerifier(auth.Config{
+		HS256Secret: cfg.Auth.JWTHS256Secret,
+		JWKSFile:    cfg.Auth.JWTJWKSFile,
+		Issuer:      cfg.Auth.JWTIssuer,
+		Audience:    cfg.Auth.JWTAudience,
+		Leeway:      time.Duration(cfg.Auth.JWTLeewaySeconds) * time.Second,
+	})
+	if err != nil {
+		zapLogger.Fatal("Failed to initialize JWT verifier", zap.Error(err))
+	}
+	if !verifier.Enabled() {
+		zapLogger.Warn("JWT_HS256_SECRET and JWT_JWKS_FILE are not set, user endpoints will reject all requests")
+	}
+	serviceAPIKeys, err := auth.ParseTokens(cfg.Auth.ServiceAPIKeys)
+	if err != nil {
+		zapLogger.Fatal("Invalid service api keys", zap.Error(err))
+	}
+	serviceAuth := auth.NewServiceAuthenticator(serviceAPIKeys, auth.ParseIdentities(cfg.Auth.ServiceMTLSIdentities))
+	if !serviceAuth.Enabled() {
+		zapLogger.Warn("SERVICE_API_KEYS and SERVICE_MTLS_IDENTITIES are not set, service endpoints will reject all requests")
+	}
+	
+	// 사용자 엔드포인트 (Authorization: Bearer {JWT}, 사용자 ID 는 토큰의 sub)
+	user := api.NewRoute().Subrouter()
+	user.Use(httpHandler.UserAuthMiddleware(verifier))
+	user.HandleFunc("/points/balance", pointHandler.GetBalance).Methods("GET")
+	user.HandleFunc("/points/transactions", pointHandler.GetTransactions).Methods("GET")
+	user.HandleFunc("/points/quote", pointHandler.Quote).Methods("POST")
+	user.HandleFunc("/orders/{id}", orderHandler.GetOrder).Methods("GET")
+	
+	// 서비스 간 엔드포인트 (X-API-Key 또는 mTLS 클라이언트 인증서, 대상 사용자는 X-User-ID)
+	service := api.PathPrefix("/internal").Subrouter()
+	service.Use(httpHandler.ServiceAuthMiddleware(serviceAuth))
+	
 	// 포인트 관련 엔드포인트
-	api.HandleFunc("/points/balance", pointHandler.GetBalance).Methods("GET")
-	api.HandleFunc("/points/transactions", pointHandler.GetTransactions).Methods("GET")
-	api.HandleFunc("/points/use", pointHandler.UsePoints).Methods("POST")
-	api.HandleFunc("/points/earn", pointHandler.EarnPoints).Methods("POST")
+	service.HandleFunc("/points/use", pointHandler.UsePoints).Methods("POST")
+	service.HandleFunc("/points/earn", pointHandler.EarnPoints).Methods("POST")
+	service.HandleFunc("/points/debts", pointHandler.GetDebtReport).Methods("GET")
+	service.HandleFunc("/points/reconcile", reconciliationHandler.ReconcileUser).Methods("POST")
+
+	// 포인트 예약 엔드포인트 (결제 전 hold / capture / release)
+	service.HandleFunc("/points/reservations", reservationHandler.ReservePoints).Methods("POST")
+	service.HandleFunc("/points/reservations/{order_id}/capture", reservationHandler.CaptureReservation).Methods("POST")
+	service.HandleFunc("/points/reservations/{order_id}/release", reservationHandler.ReleaseReservation).Methods("POST")
 	
 	// 주문 관련 엔드포인트
-	api.HandleFunc("/orders/{id}/confirm", orderHandler.ConfirmOrder).Methods("POST")
-	api.HandleFunc("/orders/{id}/refund", orderHandler.RefundOrder).Methods("POST")
+	service.HandleFunc("/orders/{id}", orderHandler.GetOrder).Methods("GET")
+	service.HandleFunc("/orders/{id}/pay", orderHandler.PayOrder).Methods("POST")
+	service.HandleFunc("/orders/{id}/confirm", orderHandler.ConfirmOrder).Methods("POST")
+	service.HandleFunc("/orders/{id}/cancel", orderHandler.CancelOrder).Methods("POST")
+	service.HandleFunc("/orders/{id}/refund", orderHandler.RefundOrder).Methods("POST")
+	service.HandleFunc("/orders/{id}/partial-refund", orderHandler.PartialRefundOrder).Methods("POST")
+
+	// 관리자 엔드포인트 (X-Admin-Token 운영자별 토큰 필요, 운영자 ID 는 토큰으로 결정)
+	operatorTokens, err := auth.ParseTokens(cfg.Admin.OperatorTokens)
+	if err != nil {
+		zapLogger.Fatal("Invalid admin operator tokens", zap.Error(err))
+	}
+	operators := auth.NewTokenSet(operatorTokens)
+	if operators.Len() == 0 {
+		zapLogger.Warn("ADMIN_OPERATOR_TOKENS is not set, admin endpoints will reject all requests")
+	}
+	admin := api.PathPrefix("/admin").Subrouter()
+	admin.Use(httpHandler.AdminAuthMiddleware(operators))
+	admin.HandleFunc("/points/credit", adminPointHandler.CreditPoints).Methods("POST")
+	admin.HandleFunc("/points/debit", adminPointHandler.DebitPoints).Methods("POST")
+	admin.HandleFunc("/points/approvals", adminPointHandler.ListApprovals).Methods("GET")
+	admin.HandleFunc("/points/approvals/{id}/approve", adminPointHandler.ApproveApproval).Methods("POST")
+	admin.HandleFunc("/points/approvals/{id}/reject", adminPointHandler.RejectApproval).Methods("POST")
+	admin.HandleFunc("/campaigns", campaignHandler.CreateCampaign).Methods("POST")
+	admin.HandleFunc("/campaigns", campaignHandler.ListCampaigns).Methods("GET")
+	admin.HandleFunc("/campaigns/{id}/cost", campaignHandler.GetCampaignCost).Methods("GET")
+	admin.HandleFunc("/webhooks", webhookHandler.CreateWebhook).Methods("POST")
+	admin.HandleFunc("/webhooks", webhookHandler.ListWebhooks).Methods("GET")
+	admin.HandleFunc("/webhooks/{id}", webhookHandler.GetWebhook).Methods("GET")
+	admin.HandleFunc("/webhooks/{id}", webhookHandler.UpdateWebhook).Methods("PATCH")
+	admin.HandleFunc("/webhooks/{id}/deliveries", webhookHandler.ListDeliveries).Methods("GET")
+	admin.HandleFunc("/webhooks/deliveries/{id}/redeliver", webhookHandler.RedeliverWebhook).Methods("POST")
 	
 	// 서버 시작
 	server := &http.Server{
@@ -104,11 +230,31 @@ func main() {
 		WriteTimeout: 15 * time.Second,
 		IdleTimeout:  60 * time.Second,
 	}
+	tlsEnabled := cfg.Server.TLSCertFile != ""
+	if tlsEnabled {
+		server.TLSConfig, err = auth.NewServerTLSConfig(cfg.Server.TLSClientCAFile)
+		if err != nil {
+			zapLogger.Fatal("Failed to initialize TLS", zap.Error(err))
+		}
+	} else if cfg.Auth.ServiceMTLSIdentities != "" {
+		zapLogger.Warn("SERVICE_MTLS_IDENTITIES is set but TLS is disabled, service endpoints accept API keys only")
+	}
+	
+	// 트랜잭션 재시도 통계 주기적 기록
+	if cfg.MySQL.TxStatsIntervalSeconds > 0 {
+		go reportTxStats(zapLogger, tm, time.Duration(cfg.MySQL.TxStatsIntervalSeconds)*time.Second)
+	}
 	
 	// Graceful shutdown
 	go func() {
-		zapLogger.Info("Server starting", zap.String("port", cfg.Server.Port))
-		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		zapLogger.Info("Server starting", zap.String("port", cfg.Server.Port), zap.Bool("tls", tlsEnabled))
+		var err error
+		if tlsEnabled {
+			err = server.ListenAndServeTLS(cfg.Server.TLSCertFile, cfg.Server.TLSKeyFile)
+		} else {
+			err = server.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
 			zapLogger.Fatal("Server failed to start", zap.Error(err))
 		}
 	}()
@@ -130,3 +276,15 @@ func main() {
 	zapLogger.Info("Server exited")
 }
 
+// reportTxStats interval 마다 직전 주기 대비 트랜잭션 재시도 통계 기록
+func reportTxStats(logger *zap.Logger, tm *mysql.TransactionManager, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last mysql.TxStats
+	for range ticker.C {
+		stats := tm.Stats()
+		mysql.LogTxStatsDelta(logger, stats, last)
+		last = stats
+	}
+}
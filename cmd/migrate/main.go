@@ -1,26 +1,48 @@
 package main
 
 import (
+	"context"
+	"flag"
+	"fmt"
 	"log"
 	"os"
+	"strconv"
 
 	"shopping-mall/config"
 	"shopping-mall/internal/infrastructure/database"
 )
 
+const usage = `Usage: migrate [--dir migrations] [--dry-run] <command>
+
+Commands:
+  up             적용되지 않은 마이그레이션 모두 적용
+  down N         최근 적용된 마이그레이션 N 개 되돌리기 (기본 1)
+  status         마이그레이션 적용 상태 조회
+  redo           마지막 마이그레이션 되돌린 후 다시 적용
+  baseline N     N 버전까지 실행하지 않고 적용된 것으로 기록 (기존 데이터베이스 도입 시 1회)
+`
+
 func main() {
 	// 설정 로드
 	cfg := config.Load()
 
-	// 마이그레이션 디렉토리
-	migrationsDir := "migrations"
-	if len(os.Args) > 1 {
-		migrationsDir = os.Args[1]
+	// 플래그 파싱
+	migrationsDir := flag.String("dir", "migrations", "마이그레이션 디렉토리")
+	dryRun := flag.Bool("dry-run", false, "실행하지 않고 실행할 SQL 만 출력")
+	flag.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() < 1 {
+		flag.Usage()
+		os.Exit(2)
 	}
+	command := flag.Arg(0)
 
-	log.Printf("Initializing database: %s", cfg.MySQL.Database)
-	log.Printf("MySQL Host: %s:%d", cfg.MySQL.Host, cfg.MySQL.Port)
-	log.Printf("MySQL User: %s", cfg.MySQL.User)
+	log.Printf("Database: %s (%s:%d, user %s)", cfg.MySQL.Database, cfg.MySQL.Host, cfg.MySQL.Port, cfg.MySQL.User)
+	log.Printf("Migrations directory: %s", *migrationsDir)
 
 	// 비밀번호 확인
 	if cfg.MySQL.Password == "" {
@@ -28,18 +50,117 @@ func main() {
 		log.Println("   Set MYSQL_PASSWORD environment variable if your MySQL requires a password.")
 	}
 
-	log.Printf("Migrations directory: %s", migrationsDir)
-
-	// 데이터베이스 초기화
-	if err := database.InitDatabase(database.Config{
+	dbConfig := database.Config{
 		Host:     cfg.MySQL.Host,
 		Port:     cfg.MySQL.Port,
 		User:     cfg.MySQL.User,
 		Password: cfg.MySQL.Password,
 		Database: cfg.MySQL.Database,
-	}, migrationsDir); err != nil {
-		log.Fatalf("Failed to initialize database: %v", err)
 	}
 
-	log.Println("✓ Database initialization completed successfully")
+	// 데이터베이스 생성 (없으면, dry-run 이면 생성하지 않음)
+	if !*dryRun {
+		if err := database.EnsureDatabase(dbConfig); err != nil {
+			log.Fatalf("Failed to ensure database: %v", err)
+		}
+	}
+
+	db, err := database.NewMySQL(dbConfig)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	migrator := database.NewMigrator(db, *migrationsDir).WithDryRun(*dryRun)
+	if *dryRun {
+		log.Println("Dry run: no SQL will be executed")
+	}
+
+	switch command {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			log.Fatalf("Failed to apply migrations (%d applied): %v", applied, err)
+		}
+		log.Printf("✓ %d migration(s) applied", applied)
+
+	case "down":
+		n, err := countArg(1)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reverted, err := migrator.Down(ctx, n)
+		if err != nil {
+			log.Fatalf("Failed to revert migrations (%d reverted): %v", reverted, err)
+		}
+		log.Printf("✓ %d migration(s) reverted", reverted)
+
+	case "redo":
+		if err := migrator.Redo(ctx); err != nil {
+			log.Fatalf("Failed to redo migration: %v", err)
+		}
+
+	case "baseline":
+		if flag.NArg() < 2 {
+			log.Fatal("baseline requires a version")
+		}
+		version, err := strconv.ParseInt(flag.Arg(1), 10, 64)
+		if err != nil {
+			log.Fatalf("invalid version: %s", flag.Arg(1))
+		}
+		marked, err := migrator.Baseline(ctx, version)
+		if err != nil {
+			log.Fatalf("Failed to baseline migrations (%d marked): %v", marked, err)
+		}
+		log.Printf("✓ %d migration(s) marked as applied", marked)
+
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			log.Fatalf("Failed to read migration status: %v", err)
+		}
+		printStatus(statuses)
+
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+// countArg 두 번째 인자를 개수로 파싱 (없으면 기본값)
+func countArg(defaultValue int) (int, error) {
+	if flag.NArg() < 2 {
+		return defaultValue, nil
+	}
+	n, err := strconv.Atoi(flag.Arg(1))
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid count: %s", flag.Arg(1))
+	}
+	return n, nil
+}
+
+// printStatus 마이그레이션 적용 상태 출력
+func printStatus(statuses []*database.MigrationStatus) {
+	pending := 0
+	for _, status := range statuses {
+		state := "pending"
+		appliedAt := ""
+		switch {
+		case status.Missing:
+			state = "missing file"
+		case status.ChecksumMismatch:
+			state = "modified"
+		case status.Applied:
+			state = "applied"
+		}
+		if status.AppliedAt != nil {
+			appliedAt = status.AppliedAt.Format("2006-01-02 15:04:05")
+		}
+		if !status.Applied {
+			pending++
+		}
+		fmt.Printf("%-14s %03d_%-50s %s\n", state, status.Version, status.Name, appliedAt)
+	}
+	fmt.Printf("\n%d migration(s), %d pending\n", len(statuses), pending)
 }
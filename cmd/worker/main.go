@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	"log"
 	"os"
 	"os/signal"
@@ -9,9 +10,18 @@ import (
 	"time"
 
 	"shopping-mall/config"
+	"shopping-mall/internal/domain/point"
+	"shopping-mall/internal/infrastructure/cache"
 	"shopping-mall/internal/infrastructure/database"
 	"shopping-mall/internal/infrastructure/logger"
+	"shopping-mall/internal/infrastructure/publisher"
+	"shopping-mall/internal/infrastructure/queue"
+	"shopping-mall/internal/infrastructure/webhook"
 	"shopping-mall/internal/repository/mysql"
+	"shopping-mall/internal/repository/redis"
+	idempotencyUseCase "shopping-mall/internal/usecase/idempotency"
+	orderUseCase "shopping-mall/internal/usecase/order"
+	orderEventUseCase "shopping-mall/internal/usecase/orderevent"
 	pointUseCase "shopping-mall/internal/usecase/point"
 
 	"go.uber.org/zap"
@@ -36,7 +46,7 @@ func main() {
 		User:     cfg.MySQL.User,
 		Password: cfg.MySQL.Password,
 		Database: cfg.MySQL.Database,
-	}, migrationsDir)
+	}, migrationsDir, cfg.MySQL.AutoMigrate)
 	if err != nil {
 		zapLogger.Fatal("Failed to connect to MySQL", zap.Error(err))
 	}
@@ -44,21 +54,168 @@ func main() {
 
 	zapLogger.Info("MySQL connected and initialized successfully")
 
+	// Redis 연결 (잔액 캐시 무효화용)
+	redisClient, err := cache.NewRedis(cache.Config{
+		Host:     cfg.Redis.Host,
+		Port:     cfg.Redis.Port,
+		Password: cfg.Redis.Password,
+		DB:       cfg.Redis.DB,
+	})
+	if err != nil {
+		zapLogger.Warn("Failed to connect to Redis, continuing without cache", zap.Error(err))
+		redisClient = nil
+	}
+
 	// Repository 초기화
-	tm := mysql.NewTransactionManager(db)
+	retryPolicy := mysql.DefaultRetryPolicy()
+	retryPolicy.MaxRetries = cfg.MySQL.TxMaxRetries
+	isolation, err := mysql.ParseIsolationLevel(cfg.MySQL.TxIsolation)
+	if err != nil {
+		zapLogger.Fatal("Invalid transaction isolation level", zap.Error(err))
+	}
+	tm := mysql.NewTransactionManager(db).
+		WithRetryPolicy(retryPolicy).
+		WithTxOptions(&sql.TxOptions{Isolation: isolation}).
+		WithLogger(zapLogger)
 	pointRepo := mysql.NewPointRepository(tm)
+	var pointCache *redis.PointCache
+	if redisClient != nil {
+		pointCache = redis.NewPointCache(redisClient)
+	}
+
+	// Policy 초기화 (API 서버와 같은 설정 사용)
+	policy, err := cfg.PointPolicy()
+	if err != nil {
+		zapLogger.Fatal("Invalid point policy", zap.Error(err))
+	}
+	gradePolicy := point.NewDefaultGradePolicy()
+	gradePolicy.WindowMonths = cfg.Worker.GradeWindowMonths
+	gradePolicy.DowngradeGraceDays = cfg.Worker.GradeDowngradeGraceDays
 
 	// UseCase 초기화
-	expireUseCase := pointUseCase.NewExpirePointsUseCase(pointRepo, tm)
+	expireUseCase := pointUseCase.NewExpirePointsUseCase(pointRepo, tm, pointCache)
+	confirmUseCase := pointUseCase.NewConfirmPendingPointsUseCase(pointRepo, tm, policy, pointCache)
+	reserveUseCase := pointUseCase.NewReservePointsUseCase(pointRepo, tm, policy, pointCache)
+	reconcileUseCase := pointUseCase.NewReconcilePointsUseCase(pointRepo, tm, policy, pointCache)
+	adminAdjustUseCase := pointUseCase.NewAdminAdjustPointsUseCase(pointRepo, tm, policy, pointCache)
+	approvalUseCase := pointUseCase.NewAdjustmentApprovalUseCase(pointRepo, tm, policy, adminAdjustUseCase)
+	gradeUseCase := pointUseCase.NewEvaluateGradesUseCase(pointRepo, tm, policy, gradePolicy, pointCache)
+	idempotentUseCase := idempotencyUseCase.NewExecuteUseCase(mysql.NewIdempotencyRepository(tm), tm, time.Duration(cfg.Idempotency.TTLHours)*time.Hour)
 
-	zapLogger.Info("Point expiration worker started")
+	// 도메인 이벤트 발행자 초기화
+	eventPublisher, err := publisher.New(publisher.Config{
+		Type:        cfg.Outbox.Publisher,
+		FilePath:    cfg.Outbox.FilePath,
+		HTTPURL:     cfg.Outbox.HTTPURL,
+		HTTPTimeout: time.Duration(cfg.Outbox.HTTPTimeoutSeconds) * time.Second,
+	}, zapLogger)
+	if err != nil {
+		zapLogger.Fatal("Failed to initialize event publisher", zap.Error(err))
+	}
+	webhookPolicy := point.DefaultDispatchPolicy()
+	webhookPolicy.MaxAttempts = cfg.Webhook.MaxAttempts
+	webhookUseCase := pointUseCase.NewWebhookUseCase(pointRepo, webhook.NewSender(time.Duration(cfg.Webhook.TimeoutSeconds)*time.Second), webhookPolicy)
+
+	// 이벤트는 설정한 발행자와 웹훅 구독(전송 내역 생성)에 함께 발행
+	dispatchPolicy := point.DefaultDispatchPolicy()
+	dispatchPolicy.MaxAttempts = cfg.Outbox.MaxAttempts
+	dispatchUseCase := pointUseCase.NewDispatchEventsUseCase(pointRepo, publisher.NewMulti(eventPublisher, webhookUseCase), dispatchPolicy)
+
+	// 주문 이벤트 소비 (소스를 설정한 경우에만)
+	var consumeUseCase *orderEventUseCase.ConsumeUseCase
+	if cfg.OrderEvents.Source != "" {
+		source, err := queue.New(queue.Config{
+			Type: cfg.OrderEvents.Source,
+			Dir:  cfg.OrderEvents.Dir,
+		})
+		if err != nil {
+			zapLogger.Fatal("Failed to initialize order event source", zap.Error(err))
+		}
+		orderEventPolicy := point.DefaultDispatchPolicy()
+		orderEventPolicy.MaxAttempts = cfg.OrderEvents.MaxAttempts
+		consumeUseCase = orderEventUseCase.NewConsumeUseCase(
+			mysql.NewOrderEventRepository(tm),
+			tm,
+			source,
+			orderUseCase.NewTransitionUseCase(
+				mysql.NewOrderRepository(tm),
+				pointRepo,
+				tm,
+				pointUseCase.NewUsePointsUseCase(pointRepo, tm, policy, pointCache),
+				pointUseCase.NewEarnPointsUseCase(pointRepo, tm, policy, pointCache),
+				pointUseCase.NewRefundPointsUseCase(pointRepo, tm, policy, pointCache),
+			),
+			orderEventPolicy,
+		)
+	}
+
+	zapLogger.Info("Point worker started")
 
 	// 매일 자정에 실행되는 틱커
 	ticker := time.NewTicker(24 * time.Hour)
 	defer ticker.Stop()
 
+	// 매시간 실행되는 적립 확정 틱커
+	confirmTicker := time.NewTicker(time.Hour)
+	defer confirmTicker.Stop()
+
+	// 매분 실행되는 예약 해제 틱커
+	holdTicker := time.NewTicker(time.Minute)
+	defer holdTicker.Stop()
+
+	// 매일 실행되는 정합성 검증 틱커
+	reconcileTicker := time.NewTicker(24 * time.Hour)
+	defer reconcileTicker.Stop()
+
+	// 매시간 실행되는 관리자 조정 승인 요청 만료 틱커
+	approvalTicker := time.NewTicker(time.Hour)
+	defer approvalTicker.Stop()
+
+	// 매시간 실행되는 만료 멱등성 키 삭제 틱커
+	idempotencyTicker := time.NewTicker(time.Hour)
+	defer idempotencyTicker.Stop()
+
+	// 매일 실행되는 회원 등급 산정 틱커
+	gradeTicker := time.NewTicker(24 * time.Hour)
+	defer gradeTicker.Stop()
+
+	// 도메인 이벤트 발행 틱커
+	dispatchTicker := time.NewTicker(time.Duration(cfg.Outbox.PollIntervalSeconds) * time.Second)
+	defer dispatchTicker.Stop()
+
+	// 웹훅 전송 틱커
+	webhookTicker := time.NewTicker(time.Duration(cfg.Webhook.PollIntervalSeconds) * time.Second)
+	defer webhookTicker.Stop()
+
+	// 주문 이벤트 소비 틱커 (소비하지 않으면 nil 채널로 대기)
+	var orderEventTick <-chan time.Time
+	if consumeUseCase != nil {
+		orderEventTicker := time.NewTicker(time.Duration(cfg.OrderEvents.PollIntervalSeconds) * time.Second)
+		defer orderEventTicker.Stop()
+		orderEventTick = orderEventTicker.C
+	}
+	lagWarn := time.Duration(cfg.OrderEvents.LagWarnSeconds) * time.Second
+
+	// 트랜잭션 재시도 통계 로그 틱커 (주기가 0 이면 nil 채널로 대기)
+	var txStatsTick <-chan time.Time
+	if cfg.MySQL.TxStatsIntervalSeconds > 0 {
+		txStatsTicker := time.NewTicker(time.Duration(cfg.MySQL.TxStatsIntervalSeconds) * time.Second)
+		defer txStatsTicker.Stop()
+		txStatsTick = txStatsTicker.C
+	}
+	var lastTxStats mysql.TxStats
+
 	// 즉시 한 번 실행
 	runExpiration(zapLogger, expireUseCase)
+	runPendingConfirmation(zapLogger, confirmUseCase)
+	runHoldRelease(zapLogger, reserveUseCase)
+	runApprovalExpiration(zapLogger, approvalUseCase)
+	runIdempotencyPurge(zapLogger, idempotentUseCase, cfg.Idempotency.PurgeBatchSize)
+	runEventDispatch(zapLogger, dispatchUseCase, cfg.Outbox.BatchSize)
+	runWebhookDelivery(zapLogger, webhookUseCase, cfg.Webhook.BatchSize)
+	if consumeUseCase != nil {
+		runOrderEventConsumption(zapLogger, consumeUseCase, cfg.OrderEvents.BatchSize, lagWarn)
+	}
 
 	// 시그널 대기 및 주기적 실행
 	quit := make(chan os.Signal, 1)
@@ -68,6 +225,28 @@ func main() {
 		select {
 		case <-ticker.C:
 			runExpiration(zapLogger, expireUseCase)
+		case <-confirmTicker.C:
+			runPendingConfirmation(zapLogger, confirmUseCase)
+		case <-holdTicker.C:
+			runHoldRelease(zapLogger, reserveUseCase)
+		case <-reconcileTicker.C:
+			runReconciliation(zapLogger, reconcileUseCase, cfg.Worker.ReconcileBatchSize, cfg.Worker.ReconcileAutoCorrect)
+		case <-approvalTicker.C:
+			runApprovalExpiration(zapLogger, approvalUseCase)
+		case <-idempotencyTicker.C:
+			runIdempotencyPurge(zapLogger, idempotentUseCase, cfg.Idempotency.PurgeBatchSize)
+		case <-gradeTicker.C:
+			runGradeEvaluation(zapLogger, gradeUseCase, cfg.Worker.GradeBatchSize, cfg.Worker.GradeUpgradeBonus)
+		case <-dispatchTicker.C:
+			runEventDispatch(zapLogger, dispatchUseCase, cfg.Outbox.BatchSize)
+		case <-webhookTicker.C:
+			runWebhookDelivery(zapLogger, webhookUseCase, cfg.Webhook.BatchSize)
+		case <-orderEventTick:
+			runOrderEventConsumption(zapLogger, consumeUseCase, cfg.OrderEvents.BatchSize, lagWarn)
+		case <-txStatsTick:
+			stats := tm.Stats()
+			mysql.LogTxStatsDelta(zapLogger, stats, lastTxStats)
+			lastTxStats = stats
 		case <-quit:
 			zapLogger.Info("Worker shutting down...")
 			return
@@ -84,10 +263,268 @@ func runExpiration(logger *zap.Logger, expireUseCase *pointUseCase.ExpirePointsU
 
 	logger.Info("Running point expiration", zap.Time("before", now))
 
-	if err := expireUseCase.ExpirePoints(ctx, now, limit); err != nil {
+	result, err := expireUseCase.ExpirePoints(ctx, now, limit)
+	if err != nil {
 		logger.Error("Failed to expire points", zap.Error(err))
+	}
+
+	logger.Info("Point expiration completed",
+		zap.Int("checked", result.Checked),
+		zap.Int("expired", result.Expired),
+		zap.Int64("amount", result.Amount),
+		zap.Int("failed", result.Failed),
+	)
+}
+
+func runPendingConfirmation(logger *zap.Logger, confirmUseCase *pointUseCase.ConfirmPendingPointsUseCase) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	now := time.Now()
+	limit := 1000 // 한 번에 처리할 최대 개수
+
+	logger.Info("Running pending point confirmation", zap.Time("before", now))
+
+	result, err := confirmUseCase.ConfirmPendingPoints(ctx, now, limit)
+	if err != nil {
+		logger.Error("Failed to confirm pending points", zap.Error(err))
+	}
+
+	logger.Info("Pending point confirmation completed",
+		zap.Int("checked", result.Checked),
+		zap.Int("confirmed", result.Confirmed),
+		zap.Int64("amount", result.Amount),
+		zap.Int("failed", result.Failed),
+	)
+}
+
+func runHoldRelease(logger *zap.Logger, reserveUseCase *pointUseCase.ReservePointsUseCase) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	now := time.Now()
+	limit := 1000 // 한 번에 처리할 최대 개수
+
+	result, err := reserveUseCase.ReleaseExpired(ctx, now, limit)
+	if err != nil {
+		logger.Error("Failed to release expired point holds", zap.Error(err))
+	}
+
+	fields := []zap.Field{
+		zap.Time("before", now),
+		zap.Int("checked", result.Checked),
+		zap.Int("released", result.Released),
+		zap.Int("failed", result.Failed),
+	}
+	if result.Released > 0 || result.Failed > 0 {
+		logger.Info("Expired point hold release completed", fields...)
+		return
+	}
+	logger.Debug("Expired point hold release completed", fields...)
+}
+
+func runApprovalExpiration(logger *zap.Logger, approvalUseCase *pointUseCase.AdjustmentApprovalUseCase) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	now := time.Now()
+	limit := 1000 // 한 번에 처리할 최대 개수
+
+	expired, err := approvalUseCase.ExpireStale(ctx, now, limit)
+	if err != nil {
+		logger.Error("Failed to expire stale adjustment approvals", zap.Error(err))
+		return
+	}
+
+	if expired > 0 {
+		logger.Info("Stale adjustment approvals expired", zap.Int("expired", expired))
+	}
+}
+
+func runIdempotencyPurge(logger *zap.Logger, idempotentUseCase *idempotencyUseCase.ExecuteUseCase, batchSize int) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	now := time.Now()
+
+	// 만료 키가 남아 있는 동안 배치 단위로 삭제
+	var purged int64
+	for {
+		deleted, err := idempotentUseCase.PurgeExpired(ctx, now, batchSize)
+		if err != nil {
+			logger.Error("Failed to purge expired idempotency keys", zap.Int64("purged", purged), zap.Error(err))
+			return
+		}
+		purged += deleted
+		if deleted == 0 || deleted < int64(batchSize) {
+			break
+		}
+	}
+
+	if purged > 0 {
+		logger.Info("Expired idempotency keys purged", zap.Time("before", now), zap.Int64("purged", purged))
+	}
+}
+
+func runReconciliation(logger *zap.Logger, reconcileUseCase *pointUseCase.ReconcilePointsUseCase, batchSize int, autoCorrect bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+
+	logger.Info("Running point reconciliation", zap.Int("batch_size", batchSize), zap.Bool("auto_correct", autoCorrect))
+
+	result, err := reconcileUseCase.ReconcileAll(ctx, batchSize, autoCorrect)
+	if err != nil {
+		logger.Error("Failed to reconcile points", zap.Error(err))
+	}
+	for _, failure := range result.Failures {
+		logger.Error("Failed to reconcile user points", zap.Int64("user_id", failure.UserID), zap.Error(failure.Err))
+	}
+
+	logger.Info("Point reconciliation completed",
+		zap.Int("checked", result.Checked),
+		zap.Int("mismatched", result.Mismatched),
+		zap.Int("corrected", result.Corrected),
+		zap.Int("failed", result.Failed),
+	)
+}
+
+func runGradeEvaluation(logger *zap.Logger, gradeUseCase *pointUseCase.EvaluateGradesUseCase, batchSize int, awardBonus bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+
+	logger.Info("Running membership grade evaluation", zap.Int("batch_size", batchSize), zap.Bool("upgrade_bonus", awardBonus))
+
+	result, err := gradeUseCase.EvaluateAll(ctx, batchSize, awardBonus)
+	if err != nil {
+		logger.Error("Failed to evaluate membership grades", zap.Error(err))
+		return
+	}
+
+	logger.Info("Membership grade evaluation completed",
+		zap.Int("checked", result.Checked),
+		zap.Int("upgraded", result.Upgraded),
+		zap.Int("downgraded", result.Downgraded),
+		zap.Int("bonuses", result.Bonuses),
+		zap.Int("failed", result.Failed),
+	)
+}
+
+func runEventDispatch(logger *zap.Logger, dispatchUseCase *pointUseCase.DispatchEventsUseCase, batchSize int) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	// 밀린 이벤트가 있으면 발행할 이벤트가 없거나 진행이 없을 때까지 배치 반복
+	total := &pointUseCase.DispatchResult{}
+	for {
+		result, err := dispatchUseCase.Dispatch(ctx, time.Now(), batchSize)
+		if result != nil {
+			total.Fetched += result.Fetched
+			total.Published += result.Published
+			total.Retrying += result.Retrying
+			total.Parked += result.Parked
+			total.Deferred += result.Deferred
+		}
+		if err != nil {
+			logger.Error("Failed to dispatch point events", zap.Error(err))
+			break
+		}
+		if result.Fetched < batchSize || result.Published == 0 {
+			break
+		}
+	}
+
+	if total.Retrying > 0 || total.Parked > 0 {
+		logger.Warn("Point event dispatch had failures",
+			zap.Int("published", total.Published),
+			zap.Int("retrying", total.Retrying),
+			zap.Int("parked", total.Parked),
+			zap.Int("deferred", total.Deferred),
+		)
+		return
+	}
+	if total.Published > 0 {
+		logger.Debug("Point event dispatch completed", zap.Int("published", total.Published))
+	}
+}
+
+func runWebhookDelivery(logger *zap.Logger, webhookUseCase *pointUseCase.WebhookUseCase, batchSize int) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	result, err := webhookUseCase.DeliverDue(ctx, time.Now(), batchSize)
+	if err != nil {
+		logger.Error("Failed to deliver webhooks", zap.Error(err))
+	}
+	if result == nil {
 		return
 	}
 
-	logger.Info("Point expiration completed")
+	if result.Retrying > 0 || result.Dead > 0 {
+		logger.Warn("Webhook delivery had failures",
+			zap.Int("delivered", result.Delivered),
+			zap.Int("retrying", result.Retrying),
+			zap.Int("dead", result.Dead),
+		)
+		return
+	}
+	if result.Delivered > 0 {
+		logger.Debug("Webhook delivery completed", zap.Int("delivered", result.Delivered))
+	}
+}
+
+func runOrderEventConsumption(logger *zap.Logger, consumeUseCase *orderEventUseCase.ConsumeUseCase, batchSize int, lagWarn time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	// 밀린 메시지가 있으면 받을 메시지가 없거나 진행이 없을 때까지 배치 반복
+	total := &orderEventUseCase.ConsumeResult{}
+	for {
+		result, err := consumeUseCase.Consume(ctx, time.Now(), batchSize)
+		if result != nil {
+			total.Fetched += result.Fetched
+			total.Processed += result.Processed
+			total.Duplicates += result.Duplicates
+			total.Skipped += result.Skipped
+			total.Retrying += result.Retrying
+			total.Parked += result.Parked
+			total.Deferred += result.Deferred
+			if result.Lag > total.Lag {
+				total.Lag = result.Lag
+			}
+		}
+		if err != nil {
+			logger.Error("Failed to consume order events", zap.Error(err))
+			break
+		}
+		if result.Fetched < batchSize || result.Processed+result.Duplicates+result.Skipped+result.Parked == 0 {
+			break
+		}
+	}
+
+	stats := consumeUseCase.Stats()
+	fields := []zap.Field{
+		zap.Int("processed", total.Processed),
+		zap.Int("duplicates", total.Duplicates),
+		zap.Int("skipped", total.Skipped),
+		zap.Int("retrying", total.Retrying),
+		zap.Int("parked", total.Parked),
+		zap.Int("deferred", total.Deferred),
+		zap.Duration("lag", total.Lag),
+		zap.Duration("last_lag", stats.LastLag),
+		zap.Duration("event_lag", stats.EventLag),
+		zap.Int64("total_processed", stats.Processed),
+		zap.Int64("total_parked", stats.Parked),
+	}
+
+	if total.Retrying > 0 || total.Parked > 0 {
+		logger.Warn("Order event consumption had failures", fields...)
+		return
+	}
+	if lagWarn > 0 && total.Lag > lagWarn {
+		logger.Warn("Order event consumption is lagging", fields...)
+		return
+	}
+	if total.Fetched > 0 {
+		logger.Debug("Order event consumption completed", fields...)
+	}
 }
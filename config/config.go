@@ -7,15 +7,29 @@ import (
 
 // Config 애플리케이션 설정
 type Config struct {
-	Server ServerConfig
-	MySQL  MySQLConfig
-	Redis  RedisConfig
+	Server  ServerConfig
+	MySQL   MySQLConfig
+	Redis   RedisConfig
+	Worker  WorkerConfig
+	Admin   AdminConfig
+	Point   PointConfig
+	Outbox  OutboxConfig
+	Webhook WebhookConfig
+	Auth    AuthConfig
+
+	OrderEvents OrderEventsConfig
+	Idempotency IdempotencyConfig
 }
 
 // ServerConfig 서버 설정
 type ServerConfig struct {
 	Port string
 	Env  string
+
+	// TLS (인증서가 없으면 HTTP 로 실행)
+	TLSCertFile     string // 서버 인증서 파일
+	TLSKeyFile      string // 서버 개인키 파일
+	TLSClientCAFile string // 서비스 mTLS 클라이언트 인증서 검증용 CA 파일 (비어 있으면 mTLS 미사용)
 }
 
 // MySQLConfig MySQL 설정
@@ -25,6 +39,19 @@ type MySQLConfig struct {
 	User     string
 	Password string
 	Database string
+
+	// 읽기 복제본 (비어 있으면 조회도 primary 사용)
+	ReplicaHost string
+	ReplicaPort int
+
+	// 시작 시 적용되지 않은 마이그레이션 자동 적용 여부 (false 면 시작 거부)
+	AutoMigrate bool
+
+	// 트랜잭션 설정
+	TxMaxRetries int    // 데드락/락 대기 시간 초과 시 최대 재시도 횟수
+	TxIsolation  string // 트랜잭션 격리 수준 (비어 있으면 DB 기본값, 예: READ-COMMITTED)
+
+	TxStatsIntervalSeconds int // 트랜잭션 재시도 통계 로그 주기 (초, 0 이면 기록하지 않음)
 }
 
 // RedisConfig Redis 설정
@@ -35,12 +62,96 @@ type RedisConfig struct {
 	DB       int
 }
 
+// WorkerConfig 배치 Worker 설정
+type WorkerConfig struct {
+	ReconcileBatchSize   int  // 정합성 검증 배치 크기
+	ReconcileAutoCorrect bool // 정합성 검증 불일치 자동 보정 여부
+
+	// 회원 등급 산정
+	GradeBatchSize          int  // 등급 산정 배치 크기
+	GradeWindowMonths       int  // 구매 실적 집계 기간 (개월)
+	GradeDowngradeGraceDays int  // 하향 유예 기간 (일)
+	GradeUpgradeBonus       bool // 승급 보너스 지급 여부
+}
+
+// AdminConfig 관리자 API 설정
+type AdminConfig struct {
+	OperatorTokens string // 운영자별 관리자 API 토큰 "운영자ID:토큰" 쉼표 구분 (비어 있으면 관리자 API 비활성화)
+
+	// 관리자 포인트 조정 승인 (maker-checker)
+	ApprovalThreshold int // 이 금액을 넘는 조정은 다른 운영자 승인 필요 (0 이면 승인 없이 실행)
+	ApprovalTTLHours  int // 승인 요청 유효시간 (시간)
+}
+
+// PointConfig 포인트 정책 설정
+type PointConfig struct {
+	EarnRounding    string // 라인별 적립 포인트 원 단위 처리 (FLOOR, ROUND, CEIL)
+	EarnOnCashOnly  bool   // 포인트로 결제한 금액을 빼고 현금 결제분에만 적립
+	WalletUseOrder  string // 사용/차감 시 지갑 순서 (쉼표 구분, 예: EVENT,REGULAR,CASH)
+	EventExpiryDays int    // 이벤트 지갑 적립 유효기간 (일)
+}
+
+// OutboxConfig 도메인 이벤트 outbox 발행 설정
+type OutboxConfig struct {
+	Publisher          string // 발행 방식 (log, file, http)
+	FilePath           string // file 발행 시 JSON Lines 파일 경로
+	HTTPURL            string // http 발행 시 수신 URL
+	HTTPTimeoutSeconds int    // http 발행 요청 시간 제한 (초)
+
+	BatchSize           int // 한 번에 조회할 이벤트 수
+	PollIntervalSeconds int // 발행 대기 이벤트 조회 주기 (초)
+	MaxAttempts         int // 최대 발행 시도 횟수 (넘으면 FAILED 로 보류, 0 이면 무제한)
+}
+
+// WebhookConfig 포인트 이벤트 웹훅 전송 설정
+type WebhookConfig struct {
+	TimeoutSeconds      int // 웹훅 요청 시간 제한 (초)
+	BatchSize           int // 한 번에 조회할 전송 내역 수
+	PollIntervalSeconds int // 전송 대기 내역 조회 주기 (초)
+	MaxAttempts         int // 최대 전송 시도 횟수 (넘으면 DEAD, 0 이면 무제한)
+}
+
+// OrderEventsConfig 주문 수명주기 이벤트 소비 설정
+type OrderEventsConfig struct {
+	Source string // 메시지 소스 (file, memory, 비어 있으면 소비하지 않음)
+	Dir    string // file 소스의 메시지 디렉터리
+
+	BatchSize           int // 한 번에 조회할 메시지 수
+	PollIntervalSeconds int // 메시지 조회 주기 (초)
+	MaxAttempts         int // 일시적 오류 최대 처리 시도 횟수 (넘으면 보관, 0 이면 무제한)
+	LagWarnSeconds      int // 소비 지연 경고 기준 (초)
+}
+
+// IdempotencyConfig 멱등성 키 설정
+type IdempotencyConfig struct {
+	TTLHours       int // 키 유효기간 (시간, 지나면 같은 키를 새 요청으로 처리)
+	PurgeBatchSize int // Worker 가 한 번에 삭제할 만료 키 수
+}
+
+// AuthConfig API 인증 설정
+type AuthConfig struct {
+	// 사용자 API (Bearer JWT, sub 클레임이 사용자 ID)
+	JWTHS256Secret   string // HS256 서명 비밀키 (비어 있으면 HS256 토큰 거부)
+	JWTJWKSFile      string // RS256 공개키 JWKS 파일 경로 (비어 있으면 RS256 토큰 거부)
+	JWTIssuer        string // 허용하는 iss (비어 있으면 검사하지 않음)
+	JWTAudience      string // 허용하는 aud (비어 있으면 검사하지 않음)
+	JWTLeewaySeconds int    // exp/nbf 검사 시 허용하는 시계 오차 (초)
+
+	// 서비스 간 API (X-API-Key 또는 mTLS 클라이언트 인증서)
+	ServiceAPIKeys        string // "서비스명:키" 쉼표 구분 목록
+	ServiceMTLSIdentities string // 허용하는 클라이언트 인증서 CN/SAN 쉼표 구분 목록
+}
+
 // Load 설정 로드
 func Load() *Config {
 	return &Config{
 		Server: ServerConfig{
 			Port: getEnv("SERVER_PORT", "3000"),
 			Env:  getEnv("ENV", "development"),
+
+			TLSCertFile:     getEnv("SERVER_TLS_CERT_FILE", ""),
+			TLSKeyFile:      getEnv("SERVER_TLS_KEY_FILE", ""),
+			TLSClientCAFile: getEnv("SERVER_TLS_CLIENT_CA_FILE", ""),
 		},
 		MySQL: MySQLConfig{
 			Host:     getEnv("MYSQL_HOST", "localhost"),
@@ -48,6 +159,16 @@ func Load() *Config {
 			User:     getEnv("MYSQL_USER", "root"),
 			Password: getEnv("MYSQL_PASSWORD", ""),
 			Database: getEnv("MYSQL_DATABASE", "shopping_mall"),
+
+			ReplicaHost: getEnv("MYSQL_REPLICA_HOST", ""),
+			ReplicaPort: getEnvAsInt("MYSQL_REPLICA_PORT", 3306),
+
+			AutoMigrate: getEnvAsBool("DB_AUTO_MIGRATE", false),
+
+			TxMaxRetries: getEnvAsInt("MYSQL_TX_MAX_RETRIES", 3),
+			TxIsolation:  getEnv("MYSQL_TX_ISOLATION", ""),
+
+			TxStatsIntervalSeconds: getEnvAsInt("MYSQL_TX_STATS_INTERVAL_SECONDS", 300),
 		},
 		Redis: RedisConfig{
 			Host:     getEnv("REDIS_HOST", "localhost"),
@@ -55,6 +176,66 @@ func Load() *Config {
 			Password: getEnv("REDIS_PASSWORD", ""),
 			DB:       getEnvAsInt("REDIS_DB", 0),
 		},
+		Worker: WorkerConfig{
+			ReconcileBatchSize:   getEnvAsInt("RECONCILE_BATCH_SIZE", 500),
+			ReconcileAutoCorrect: getEnvAsBool("RECONCILE_AUTO_CORRECT", false),
+
+			GradeBatchSize:          getEnvAsInt("GRADE_BATCH_SIZE", 500),
+			GradeWindowMonths:       getEnvAsInt("GRADE_WINDOW_MONTHS", 12),
+			GradeDowngradeGraceDays: getEnvAsInt("GRADE_DOWNGRADE_GRACE_DAYS", 30),
+			GradeUpgradeBonus:       getEnvAsBool("GRADE_UPGRADE_BONUS", true),
+		},
+		Admin: AdminConfig{
+			OperatorTokens: getEnv("ADMIN_OPERATOR_TOKENS", ""),
+
+			ApprovalThreshold: getEnvAsInt("ADMIN_APPROVAL_THRESHOLD", 100000),
+			ApprovalTTLHours:  getEnvAsInt("ADMIN_APPROVAL_TTL_HOURS", 72),
+		},
+		Point: PointConfig{
+			EarnRounding:    getEnv("POINT_EARN_ROUNDING", "FLOOR"),
+			EarnOnCashOnly:  getEnvAsBool("POINT_EARN_ON_CASH_ONLY", true),
+			WalletUseOrder:  getEnv("POINT_WALLET_USE_ORDER", "EVENT,REGULAR,CASH"),
+			EventExpiryDays: getEnvAsInt("POINT_EVENT_EXPIRY_DAYS", 30),
+		},
+		Outbox: OutboxConfig{
+			Publisher:          getEnv("OUTBOX_PUBLISHER", "log"),
+			FilePath:           getEnv("OUTBOX_FILE_PATH", "point-events.jsonl"),
+			HTTPURL:            getEnv("OUTBOX_HTTP_URL", ""),
+			HTTPTimeoutSeconds: getEnvAsInt("OUTBOX_HTTP_TIMEOUT_SECONDS", 5),
+
+			BatchSize:           getEnvAsInt("OUTBOX_BATCH_SIZE", 100),
+			PollIntervalSeconds: getEnvAsInt("OUTBOX_POLL_INTERVAL_SECONDS", 5),
+			MaxAttempts:         getEnvAsInt("OUTBOX_MAX_ATTEMPTS", 10),
+		},
+		Webhook: WebhookConfig{
+			TimeoutSeconds:      getEnvAsInt("WEBHOOK_TIMEOUT_SECONDS", 5),
+			BatchSize:           getEnvAsInt("WEBHOOK_BATCH_SIZE", 100),
+			PollIntervalSeconds: getEnvAsInt("WEBHOOK_POLL_INTERVAL_SECONDS", 5),
+			MaxAttempts:         getEnvAsInt("WEBHOOK_MAX_ATTEMPTS", 8),
+		},
+		Auth: AuthConfig{
+			JWTHS256Secret:   getEnv("JWT_HS256_SECRET", ""),
+			JWTJWKSFile:      getEnv("JWT_JWKS_FILE", ""),
+			JWTIssuer:        getEnv("JWT_ISSUER", ""),
+			JWTAudience:      getEnv("JWT_AUDIENCE", ""),
+			JWTLeewaySeconds: getEnvAsInt("JWT_LEEWAY_SECONDS", 30),
+
+			ServiceAPIKeys:        getEnv("SERVICE_API_KEYS", ""),
+			ServiceMTLSIdentities: getEnv("SERVICE_MTLS_IDENTITIES", ""),
+		},
+		OrderEvents: OrderEventsConfig{
+			Source: getEnv("ORDER_EVENTS_SOURCE", ""),
+			Dir:    getEnv("ORDER_EVENTS_DIR", "order-events"),
+
+			BatchSize:           getEnvAsInt("ORDER_EVENTS_BATCH_SIZE", 100),
+			PollIntervalSeconds: getEnvAsInt("ORDER_EVENTS_POLL_INTERVAL_SECONDS", 1),
+			MaxAttempts:         getEnvAsInt("ORDER_EVENTS_MAX_ATTEMPTS", 5),
+			LagWarnSeconds:      getEnvAsInt("ORDER_EVENTS_LAG_WARN_SECONDS", 60),
+		},
+		Idempotency: IdempotencyConfig{
+			TTLHours:       getEnvAsInt("IDEMPOTENCY_TTL_HOURS", 168),
+			PurgeBatchSize: getEnvAsInt("IDEMPOTENCY_PURGE_BATCH_SIZE", 1000),
+		},
 	}
 }
 
@@ -73,3 +254,12 @@ func getEnvAsInt(key string, defaultValue int) int {
 	}
 	return defaultValue
 }
+
+func getEnvAsBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolValue, err := strconv.ParseBool(value); err == nil {
+			return boolValue
+		}
+	}
+	return defaultValue
+}
@@ -0,0 +1,25 @@
+package config
+
+import (
+	"shopping-mall/internal/domain/point"
+)
+
+// PointPolicy 설정으로 포인트 정책 생성 (API 서버와 워커가 같은 정책을 사용)
+func (c *Config) PointPolicy() (*point.Policy, error) {
+	policy := point.NewDefaultPolicy()
+	policy.AdminApprovalThreshold = int64(c.Admin.ApprovalThreshold)
+	policy.AdminApprovalTTLHours = c.Admin.ApprovalTTLHours
+	policy.EarnOnCashOnly = c.Point.EarnOnCashOnly
+
+	var err error
+	policy.EarnRounding, err = point.ParseRoundingMode(c.Point.EarnRounding)
+	if err != nil {
+		return nil, err
+	}
+	policy.WalletUseOrder, err = point.ParseWalletOrder(c.Point.WalletUseOrder)
+	if err != nil {
+		return nil, err
+	}
+	policy.WalletRules[point.WalletEvent] = point.WalletRule{ExpiryDays: c.Point.EventExpiryDays}
+	return policy, nil
+}
@@ -0,0 +1,14 @@
+package idempotency
+
+import "errors"
+
+var (
+	// ErrKeyReused 같은 키를 다른 페이로드로 재사용
+	ErrKeyReused = errors.New("idempotency key reused with different payload")
+
+	// ErrDuplicateKey 이미 등록된 키
+	ErrDuplicateKey = errors.New("duplicate idempotency key")
+
+	// ErrRecordNotFound 멱등성 키 기록 없음
+	ErrRecordNotFound = errors.New("idempotency record not found")
+)
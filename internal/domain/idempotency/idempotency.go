@@ -0,0 +1,39 @@
+package idempotency
+
+import "time"
+
+// Record 멱등성 키 기록
+type Record struct {
+	ID             int64
+	Scope          string // 요청 범위 (메서드, 경로, 사용자)
+	Key            string // Idempotency-Key 헤더 또는 주문 기반 자연 키
+	RequestHash    string // 요청 페이로드 해시
+	ResponseStatus int
+	ResponseBody   []byte
+	CreatedAt      time.Time
+	ExpiresAt      time.Time // 지나면 같은 키를 새 요청으로 처리
+}
+
+// Response 기록된 응답
+type Response struct {
+	Status int
+	Body   []byte
+}
+
+// Matches 동일한 페이로드로 재요청했는지 확인
+func (r *Record) Matches(requestHash string) bool {
+	return r.RequestHash == requestHash
+}
+
+// IsExpired 유효기간이 지난 키인지 확인
+func (r *Record) IsExpired(now time.Time) bool {
+	return now.After(r.ExpiresAt)
+}
+
+// Response 기록된 응답 반환
+func (r *Record) Response() *Response {
+	return &Response{
+		Status: r.ResponseStatus,
+		Body:   r.ResponseBody,
+	}
+}
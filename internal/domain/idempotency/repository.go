@@ -0,0 +1,30 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+)
+
+// Repository 멱등성 키 리포지토리 인터페이스
+type Repository interface {
+	// Create 멱등성 키 선점 (이미 있으면 ErrDuplicateKey)
+	Create(ctx context.Context, record *Record) error
+
+	// Get 멱등성 키 조회 (락 포함)
+	Get(ctx context.Context, scope, key string) (*Record, error)
+
+	// UpdateResponse 응답 기록
+	UpdateResponse(ctx context.Context, record *Record) error
+
+	// Delete 멱등성 키 삭제
+	Delete(ctx context.Context, id int64) error
+
+	// DeleteExpired before 이전에 만료된 키를 최대 limit 개 삭제 (삭제한 개수 반환)
+	DeleteExpired(ctx context.Context, before time.Time, limit int) (int64, error)
+}
+
+// TransactionManager 트랜잭션 관리자 인터페이스
+type TransactionManager interface {
+	// WithTransaction 트랜잭션 내에서 함수 실행
+	WithTransaction(ctx context.Context, fn func(context.Context) error) error
+}
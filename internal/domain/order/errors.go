@@ -0,0 +1,23 @@
+package order
+
+import "errors"
+
+var (
+	// ErrOrderNotFound 주문 없음
+	ErrOrderNotFound = errors.New("order not found")
+
+	// ErrInvalidTransition 현재 주문 상태에서 허용되지 않는 상태 전이
+	ErrInvalidTransition = errors.New("invalid order status transition")
+
+	// ErrOrderCancelled 이미 취소된 주문
+	ErrOrderCancelled = errors.New("order already cancelled")
+
+	// ErrInvalidOrderAmount 잘못된 주문 금액/사용 포인트
+	ErrInvalidOrderAmount = errors.New("invalid order amount")
+
+	// ErrTotalMismatch 요청 결제 금액이 기록된 주문 금액과 다름
+	ErrTotalMismatch = errors.New("payment amount does not match recorded order total")
+
+	// ErrPointTotalsMismatch 기록된 주문 포인트와 주문의 포인트 거래 내역이 다름
+	ErrPointTotalsMismatch = errors.New("order point totals do not match point transactions")
+)
@@ -0,0 +1,142 @@
+package order
+
+import "time"
+
+// Status 주문 상태
+type Status string
+
+const (
+	StatusPending           Status = "PENDING"            // 주문 생성 (결제 전)
+	StatusPaid              Status = "PAID"               // 결제 완료 (사용 포인트 차감)
+	StatusConfirmed         Status = "CONFIRMED"          // 구매 확정 (적립 예정 포인트 등록)
+	StatusPartiallyRefunded Status = "PARTIALLY_REFUNDED" // 부분 환불
+	StatusRefunded          Status = "REFUNDED"           // 전체 환불
+	StatusCancelled         Status = "CANCELLED"          // 구매 확정 전 취소
+)
+
+// transitions 상태별 허용되는 다음 상태
+// PENDING → PAID → CONFIRMED → PARTIALLY_REFUNDED → REFUNDED
+// 구매 확정 전에는 취소하거나 환불할 수 있고, 확정 후에는 환불만 가능
+var transitions = map[Status][]Status{
+	StatusPending:           {StatusPaid, StatusCancelled},
+	StatusPaid:              {StatusConfirmed, StatusPartiallyRefunded, StatusRefunded, StatusCancelled},
+	StatusConfirmed:         {StatusPartiallyRefunded, StatusRefunded},
+	StatusPartiallyRefunded: {StatusPartiallyRefunded, StatusRefunded},
+}
+
+// Order 주문 (포인트 사용/적립 기준 금액과 상태)
+type Order struct {
+	ID            int64 // 주문 서비스의 주문 ID
+	UserID        int64
+	TotalAmount   int64 // 주문 결제 금액 (포인트 사용분 포함)
+	PointUsed     int64 // 결제에 사용한 포인트
+	PointToEarn   int64 // 구매 확정 시 적립 예정 포인트
+	PaymentAmount int64 // 실제 결제 금액 (TotalAmount - PointUsed)
+	Status        Status
+	ConfirmedAt   *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// New 결제 전 주문 생성
+func New(id, userID int64, now time.Time) *Order {
+	return &Order{
+		ID:        id,
+		UserID:    userID,
+		Status:    StatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// Adopt 상태가 기록되지 않은 주문을 포인트 거래 내역으로 복원
+// (포인트 사용 API/예약 확정으로 결제했거나 주문 기록 도입 이전 주문)
+// 구매 적립 내역이 있으면 구매 확정, 없으면 결제 완료 상태로 복원
+func Adopt(id, userID, totalAmount, pointUsed, pointToEarn int64, now time.Time) *Order {
+	order := New(id, userID, now)
+	order.TotalAmount = totalAmount
+	order.PointUsed = pointUsed
+	order.PointToEarn = pointToEarn
+	if totalAmount > pointUsed {
+		order.PaymentAmount = totalAmount - pointUsed
+	}
+	order.Status = StatusPaid
+	if pointToEarn > 0 {
+		order.Status = StatusConfirmed
+	}
+	return order
+}
+
+// CanTransitionTo 다음 상태로 전이할 수 있는지 확인
+func (o *Order) CanTransitionTo(next Status) bool {
+	for _, allowed := range transitions[o.Status] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// transition 상태 전이 (허용되지 않으면 ErrInvalidTransition)
+func (o *Order) transition(next Status, now time.Time) error {
+	if !o.CanTransitionTo(next) {
+		return ErrInvalidTransition
+	}
+	o.Status = next
+	o.UpdatedAt = now
+	return nil
+}
+
+// Pay 결제 완료 처리 (주문 금액과 사용 포인트 기록)
+func (o *Order) Pay(totalAmount, pointUsed int64, now time.Time) error {
+	if totalAmount <= 0 || pointUsed < 0 || pointUsed > totalAmount {
+		return ErrInvalidOrderAmount
+	}
+	if err := o.transition(StatusPaid, now); err != nil {
+		return err
+	}
+	o.TotalAmount = totalAmount
+	o.PointUsed = pointUsed
+	o.PaymentAmount = totalAmount - pointUsed
+	return nil
+}
+
+// Confirm 구매 확정 처리 (적립 예정 포인트 기록)
+func (o *Order) Confirm(pointToEarn int64, now time.Time) error {
+	if err := o.transition(StatusConfirmed, now); err != nil {
+		return err
+	}
+	o.PointToEarn = pointToEarn
+	o.ConfirmedAt = &now
+	return nil
+}
+
+// Refund 환불 처리 (남은 결제 금액이 없으면 전체 환불)
+func (o *Order) Refund(fullyRefunded bool, now time.Time) error {
+	if fullyRefunded {
+		return o.transition(StatusRefunded, now)
+	}
+	return o.transition(StatusPartiallyRefunded, now)
+}
+
+// Cancel 구매 확정 전 취소 처리
+func (o *Order) Cancel(now time.Time) error {
+	return o.transition(StatusCancelled, now)
+}
+
+// MatchesTotal 요청 결제 금액이 기록된 주문 금액과 같은지 검증
+// 금액을 모르는 채 복원한 주문(TotalAmount 0)은 검증하지 않음
+func (o *Order) MatchesTotal(paymentAmount int64) error {
+	if o.TotalAmount > 0 && paymentAmount != o.TotalAmount {
+		return ErrTotalMismatch
+	}
+	return nil
+}
+
+// ValidatePointUsed 주문의 포인트 사용 거래 합계가 기록된 사용 포인트와 같은지 검증
+func (o *Order) ValidatePointUsed(used int64) error {
+	if used != o.PointUsed {
+		return ErrPointTotalsMismatch
+	}
+	return nil
+}
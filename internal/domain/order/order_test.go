@@ -0,0 +1,170 @@
+package order
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOrderCanTransitionTo(t *testing.T) {
+	tests := []struct {
+		from Status
+		to   Status
+		want bool
+	}{
+		{StatusPending, StatusPaid, true},
+		{StatusPending, StatusCancelled, true},
+		{StatusPending, StatusConfirmed, false},
+		{StatusPending, StatusRefunded, false},
+		{StatusPaid, StatusConfirmed, true},
+		{StatusPaid, StatusPartiallyRefunded, true},
+		{StatusPaid, StatusRefunded, true},
+		{StatusPaid, StatusCancelled, true},
+		{StatusPaid, StatusPaid, false},
+		{StatusConfirmed, StatusPartiallyRefunded, true},
+		{StatusConfirmed, StatusRefunded, true},
+		{StatusConfirmed, StatusCancelled, false},
+		{StatusConfirmed, StatusPaid, false},
+		{StatusPartiallyRefunded, StatusPartiallyRefunded, true},
+		{StatusPartiallyRefunded, StatusRefunded, true},
+		{StatusPartiallyRefunded, StatusCancelled, false},
+		{StatusRefunded, StatusPartiallyRefunded, false},
+		{StatusRefunded, StatusRefunded, false},
+		{StatusCancelled, StatusPaid, false},
+		{StatusCancelled, StatusRefunded, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from)+"->"+string(tt.to), func(t *testing.T) {
+			o := &Order{Status: tt.from}
+			if got := o.CanTransitionTo(tt.to); got != tt.want {
+				t.Errorf("CanTransitionTo(%s) from %s = %v, want %v", tt.to, tt.from, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderPay(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		status    Status
+		total     int64
+		pointUsed int64
+		wantErr   error
+	}{
+		{"pending order", StatusPending, 10000, 3000, nil},
+		{"no points used", StatusPending, 10000, 0, nil},
+		{"all points", StatusPending, 10000, 10000, nil},
+		{"zero total", StatusPending, 0, 0, ErrInvalidOrderAmount},
+		{"negative points", StatusPending, 10000, -1, ErrInvalidOrderAmount},
+		{"points exceed total", StatusPending, 10000, 10001, ErrInvalidOrderAmount},
+		{"already paid", StatusPaid, 10000, 3000, ErrInvalidTransition},
+		{"cancelled", StatusCancelled, 10000, 3000, ErrInvalidTransition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := New(1, 10, now)
+			o.Status = tt.status
+
+			err := o.Pay(tt.total, tt.pointUsed, now)
+			if err != tt.wantErr {
+				t.Fatalf("Pay() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if o.Status != tt.status {
+					t.Errorf("status changed to %s on error", o.Status)
+				}
+				return
+			}
+			if o.Status != StatusPaid {
+				t.Errorf("status = %s, want %s", o.Status, StatusPaid)
+			}
+			if o.PaymentAmount != tt.total-tt.pointUsed {
+				t.Errorf("PaymentAmount = %d, want %d", o.PaymentAmount, tt.total-tt.pointUsed)
+			}
+		})
+	}
+}
+
+func TestOrderLifecycle(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	o := New(1, 10, now)
+	if err := o.Pay(10000, 2000, now); err != nil {
+		t.Fatalf("Pay() error = %v", err)
+	}
+	if err := o.Confirm(400, now); err != nil {
+		t.Fatalf("Confirm() error = %v", err)
+	}
+	if o.PointToEarn != 400 || o.ConfirmedAt == nil {
+		t.Errorf("Confirm() did not record point to earn / confirmed at: %+v", o)
+	}
+	if err := o.Cancel(now); err != ErrInvalidTransition {
+		t.Errorf("Cancel() after confirm error = %v, want %v", err, ErrInvalidTransition)
+	}
+	if err := o.Refund(false, now); err != nil || o.Status != StatusPartiallyRefunded {
+		t.Fatalf("Refund(partial) = %v, status %s", err, o.Status)
+	}
+	if err := o.Refund(false, now); err != nil || o.Status != StatusPartiallyRefunded {
+		t.Fatalf("second Refund(partial) = %v, status %s", err, o.Status)
+	}
+	if err := o.Refund(true, now); err != nil || o.Status != StatusRefunded {
+		t.Fatalf("Refund(full) = %v, status %s", err, o.Status)
+	}
+	if err := o.Refund(true, now); err != ErrInvalidTransition {
+		t.Errorf("Refund() after full refund error = %v, want %v", err, ErrInvalidTransition)
+	}
+}
+
+func TestAdopt(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		total       int64
+		used        int64
+		earned      int64
+		wantStatus  Status
+		wantPayment int64
+	}{
+		{"used only", 10000, 2000, 0, StatusPaid, 8000},
+		{"earned", 10000, 2000, 400, StatusConfirmed, 8000},
+		{"unknown total", 0, 2000, 0, StatusPaid, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := Adopt(1, 10, tt.total, tt.used, tt.earned, now)
+			if o.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s", o.Status, tt.wantStatus)
+			}
+			if o.PaymentAmount != tt.wantPayment {
+				t.Errorf("PaymentAmount = %d, want %d", o.PaymentAmount, tt.wantPayment)
+			}
+		})
+	}
+}
+
+func TestOrderMatchesTotal(t *testing.T) {
+	tests := []struct {
+		name    string
+		total   int64
+		payment int64
+		wantErr error
+	}{
+		{"same", 10000, 10000, nil},
+		{"different", 10000, 9000, ErrTotalMismatch},
+		{"unknown total", 0, 9000, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &Order{TotalAmount: tt.total}
+			if err := o.MatchesTotal(tt.payment); err != tt.wantErr {
+				t.Errorf("MatchesTotal() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package order
+
+import "context"
+
+// Repository 주문 리포지토리 인터페이스
+type Repository interface {
+	// Create 주문 생성 (ID 는 주문 서비스의 주문 ID 사용)
+	Create(ctx context.Context, order *Order) error
+
+	// Get 주문 조회
+	Get(ctx context.Context, id int64) (*Order, error)
+
+	// GetForUpdate 주문 조회 (락 포함)
+	GetForUpdate(ctx context.Context, id int64) (*Order, error)
+
+	// Update 주문 상태/금액 업데이트
+	Update(ctx context.Context, order *Order) error
+}
+
+// TransactionManager 트랜잭션 관리자 인터페이스
+type TransactionManager interface {
+	// WithTransaction 트랜잭션 내에서 함수 실행
+	WithTransaction(ctx context.Context, fn func(context.Context) error) error
+}
@@ -0,0 +1,17 @@
+package orderevent
+
+import "errors"
+
+var (
+	// ErrDuplicateEvent 이미 처리한 이벤트
+	ErrDuplicateEvent = errors.New("order event already processed")
+
+	// ErrInvalidEvent 필수 값이 없거나 해석할 수 없는 이벤트
+	ErrInvalidEvent = errors.New("invalid order event")
+
+	// ErrUnknownEventType 처리하지 않는 이벤트 유형
+	ErrUnknownEventType = errors.New("unknown order event type")
+
+	// ErrAttemptNotFound 메시지 처리 실패 기록 없음
+	ErrAttemptNotFound = errors.New("order event message attempt not found")
+)
@@ -0,0 +1,148 @@
+package orderevent
+
+import (
+	"context"
+	"time"
+
+	"shopping-mall/internal/domain/point"
+)
+
+// EventType 주문 수명주기 이벤트 유형
+type EventType string
+
+const (
+	EventTypeOrderPaid      EventType = "ORDER_PAID"      // 결제 완료 (사용 포인트 차감)
+	EventTypeOrderConfirmed EventType = "ORDER_CONFIRMED" // 구매 확정 (적립 예정 포인트 등록)
+	EventTypeOrderCancelled EventType = "ORDER_CANCELLED" // 주문 취소 (사용 포인트 복구, 적립 예정 포인트 취소)
+	EventTypeOrderRefunded  EventType = "ORDER_REFUNDED"  // 환불 (전체 또는 부분 환불)
+)
+
+// IsValid 처리하는 이벤트 유형인지 확인
+func (t EventType) IsValid() bool {
+	switch t {
+	case EventTypeOrderPaid, EventTypeOrderConfirmed, EventTypeOrderCancelled, EventTypeOrderRefunded:
+		return true
+	}
+	return false
+}
+
+// Event 주문 서비스가 발행하는 주문 수명주기 이벤트
+type Event struct {
+	EventID    string // 이벤트 고유 ID (중복 처리 방지 키)
+	EventType  EventType
+	OrderID    int64
+	UserID     int64
+	OccurredAt time.Time // 주문 서비스에서 이벤트가 발생한 시각
+
+	// ORDER_PAID
+	OrderAmount int64 // 주문 금액 (사용 한도 검증)
+	PointUsed   int64 // 결제에 사용한 포인트
+
+	// ORDER_CONFIRMED, ORDER_REFUNDED
+	PaymentAmount int64 // 결제 금액 (포인트 사용분 포함)
+	Categories    []string
+	Items         []point.LineItem
+
+	// ORDER_REFUNDED (RefundPaymentAmount 가 0 이면 전체 환불)
+	RefundPaymentAmount int64
+	RefundPointAmount   *int64
+	RefundLines         []point.RefundLine
+}
+
+// Validate 이벤트 필수 값 검증
+// 같은 토픽의 다른 유형 이벤트는 필수 값과 관계없이 ErrUnknownEventType
+func (e *Event) Validate() error {
+	if !e.EventType.IsValid() {
+		return ErrUnknownEventType
+	}
+	if e.EventID == "" || e.OrderID <= 0 || e.UserID <= 0 {
+		return ErrInvalidEvent
+	}
+	if e.PointUsed < 0 || e.RefundPaymentAmount < 0 {
+		return ErrInvalidEvent
+	}
+	return nil
+}
+
+// IsPartialRefund 부분 환불 이벤트인지 확인
+func (e *Event) IsPartialRefund() bool {
+	return e.EventType == EventTypeOrderRefunded && e.RefundPaymentAmount > 0
+}
+
+// ProcessedEvent 처리 완료한 이벤트 기록 (이벤트 ID 중복 제거용)
+type ProcessedEvent struct {
+	EventID     string
+	EventType   EventType
+	OrderID     int64
+	UserID      int64
+	OccurredAt  time.Time
+	ProcessedAt time.Time
+}
+
+// NewProcessedEvent 이벤트 처리 기록 생성
+func NewProcessedEvent(event *Event, now time.Time) *ProcessedEvent {
+	return &ProcessedEvent{
+		EventID:     event.EventID,
+		EventType:   event.EventType,
+		OrderID:     event.OrderID,
+		UserID:      event.UserID,
+		OccurredAt:  event.OccurredAt,
+		ProcessedAt: now,
+	}
+}
+
+// ParkedMessage 재시도해도 처리할 수 없어 보관한 메시지 (poison message)
+type ParkedMessage struct {
+	ID        int64
+	MessageID string    // 메시지 소스의 메시지 ID
+	EventID   string    // 해석에 실패하면 빈 값
+	EventType EventType // 해석에 실패하면 빈 값
+	Body      []byte    // 원본 메시지
+	Reason    string    // 보관 사유 (마지막 처리 오류)
+	Attempts  int       // 처리 시도 횟수
+	ParkedAt  time.Time
+}
+
+// MessageAttempt 일시적 오류로 처리에 실패한 메시지의 시도 기록 (재시작해도 유지)
+type MessageAttempt struct {
+	MessageID     string
+	Attempts      int       // 실패한 처리 시도 횟수
+	NextAttemptAt time.Time // 다음 처리 시도 시각 (지수 백오프)
+	LastError     string
+	UpdatedAt     time.Time
+}
+
+// IsDue now 시점에 다시 처리할 수 있는지 확인
+func (a *MessageAttempt) IsDue(now time.Time) bool {
+	return !now.Before(a.NextAttemptAt)
+}
+
+// Fail 처리 실패 기록 후 재시도 정책에 따라 다음 시도 시각 설정
+// 최대 시도 횟수에 도달하면 true 를 반환 (보관 대상)
+func (a *MessageAttempt) Fail(cause error, now time.Time, policy point.DispatchPolicy) bool {
+	a.Attempts++
+	a.LastError = cause.Error()
+	a.UpdatedAt = now
+	if policy.MaxAttempts > 0 && a.Attempts >= policy.MaxAttempts {
+		return true
+	}
+	a.NextAttemptAt = now.Add(policy.Backoff(a.Attempts))
+	return false
+}
+
+// Message 메시지 소스에서 받은 메시지
+type Message struct {
+	ID         string    // 소스 내 메시지 식별자 (Ack 기준)
+	Body       []byte    // 이벤트 JSON
+	ReceivedAt time.Time // 소스에 들어온 시각 (소비 지연 측정용)
+}
+
+// Source 주문 이벤트 메시지 소스 인터페이스 (파일/메모리 큐, 메시지 브로커 등으로 교체 가능)
+type Source interface {
+	// Fetch 확인(Ack)하지 않은 메시지를 들어온 순서대로 최대 limit 개 조회
+	// 확인하지 않은 메시지는 다음 Fetch 에서 다시 전달
+	Fetch(ctx context.Context, limit int) ([]*Message, error)
+
+	// Ack 처리가 끝난 메시지 확인 (처리 완료, 중복, 보관 모두 포함)
+	Ack(ctx context.Context, msg *Message) error
+}
@@ -0,0 +1,27 @@
+package orderevent
+
+import "context"
+
+// Repository 주문 이벤트 처리 기록 리포지토리 인터페이스
+type Repository interface {
+	// CreateProcessedEvent 처리한 이벤트 기록 (이미 있으면 ErrDuplicateEvent)
+	CreateProcessedEvent(ctx context.Context, processed *ProcessedEvent) error
+
+	// CreateParkedMessage 처리할 수 없는 메시지 보관
+	CreateParkedMessage(ctx context.Context, parked *ParkedMessage) error
+
+	// GetMessageAttempt 메시지 처리 실패 기록 조회 (없으면 ErrAttemptNotFound)
+	GetMessageAttempt(ctx context.Context, messageID string) (*MessageAttempt, error)
+
+	// SaveMessageAttempt 메시지 처리 실패 기록 저장 (없으면 생성, 있으면 갱신)
+	SaveMessageAttempt(ctx context.Context, attempt *MessageAttempt) error
+
+	// DeleteMessageAttempt 처리가 끝난 메시지의 실패 기록 삭제
+	DeleteMessageAttempt(ctx context.Context, messageID string) error
+}
+
+// TransactionManager 트랜잭션 관리자 인터페이스
+type TransactionManager interface {
+	// WithTransaction 트랜잭션 내에서 함수 실행
+	WithTransaction(ctx context.Context, fn func(context.Context) error) error
+}
@@ -0,0 +1,40 @@
+package point
+
+import "strings"
+
+// AdminAdjustment 관리자 수동 포인트 지급/차감 요청
+type AdminAdjustment struct {
+	UserID        int64
+	Amount        int64
+	Reason        string // 지급/차감 사유 (필수)
+	TicketRef     string // 고객 문의 티켓 번호 (필수)
+	OperatorID    string // 처리 운영자 ID (필수)
+	ExpiresInDays int    // 지급 포인트 유효기간 (일, 0 이면 지갑별 기본 정책)
+	Wallet        Wallet // 지급 지갑 (비어 있으면 일반 포인트, 지급에만 적용)
+}
+
+// Validate 필수 항목 검증
+func (a *AdminAdjustment) Validate() error {
+	if a.UserID <= 0 {
+		return ErrInvalidUserID
+	}
+	if a.Amount <= 0 {
+		return ErrInvalidAdjustmentAmount
+	}
+	if strings.TrimSpace(a.Reason) == "" {
+		return ErrAdjustmentReasonRequired
+	}
+	if strings.TrimSpace(a.TicketRef) == "" {
+		return ErrTicketRefRequired
+	}
+	if strings.TrimSpace(a.OperatorID) == "" {
+		return ErrOperatorRequired
+	}
+	if a.ExpiresInDays < 0 {
+		return ErrInvalidExpiryDays
+	}
+	if a.Wallet != "" && !a.Wallet.IsValid() {
+		return ErrInvalidWallet
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package point
+
+import "time"
+
+// Allocation 포인트 사용 시 적립 lot 에서 차감된 내역
+type Allocation struct {
+	ID                int64
+	UserID            int64
+	UseTransactionID  int64 // 사용 거래 ID
+	EarnTransactionID int64 // 차감된 적립 거래 ID
+	Amount            int64 // 차감 금액
+	RestoredAmount    int64 // 환불로 복구된 금액
+	CreatedAt         time.Time
+}
+
+// Restorable 아직 복구되지 않은 차감 금액
+func (a *Allocation) Restorable() int64 {
+	return a.Amount - a.RestoredAmount
+}
+
+// Restore 차감 금액 복구 처리
+func (a *Allocation) Restore(amount int64) {
+	if amount > a.Restorable() {
+		amount = a.Restorable()
+	}
+	a.RestoredAmount += amount
+}
@@ -0,0 +1,131 @@
+package point
+
+import "time"
+
+// AdjustmentDirection 관리자 포인트 조정 구분
+type AdjustmentDirection string
+
+const (
+	AdjustmentDirectionCredit AdjustmentDirection = "CREDIT" // 지급
+	AdjustmentDirectionDebit  AdjustmentDirection = "DEBIT"  // 차감
+)
+
+// ApprovalStatus 관리자 포인트 조정 승인 상태
+type ApprovalStatus string
+
+const (
+	ApprovalStatusPending  ApprovalStatus = "PENDING"  // 승인 대기
+	ApprovalStatusApproved ApprovalStatus = "APPROVED" // 승인 (조정 실행됨)
+	ApprovalStatusRejected ApprovalStatus = "REJECTED" // 반려
+	ApprovalStatusExpired  ApprovalStatus = "EXPIRED"  // 승인 대기 시간 초과
+)
+
+// AdjustmentApproval 기준 금액을 넘는 관리자 포인트 조정의 승인 요청 (maker-checker)
+// 요청한 운영자가 아닌 다른 운영자가 승인해야 조정이 실행됨
+type AdjustmentApproval struct {
+	ID            int64
+	Direction     AdjustmentDirection
+	UserID        int64
+	Amount        int64
+	Reason        string
+	TicketRef     string
+	ExpiresInDays int
+	Wallet        Wallet
+	RequestedBy   string // 요청 운영자 ID
+	ReviewedBy    string // 승인/반려 운영자 ID
+	ReviewNote    string // 승인/반려 메모
+	TransactionID *int64 // 승인으로 생성된 거래 ID
+	Status        ApprovalStatus
+	ExpiresAt     time.Time
+	ReviewedAt    *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// NewAdjustmentApproval 관리자 포인트 조정 승인 요청 생성
+func NewAdjustmentApproval(direction AdjustmentDirection, adjustment *AdminAdjustment, expiresAt time.Time) *AdjustmentApproval {
+	return &AdjustmentApproval{
+		Direction:     direction,
+		UserID:        adjustment.UserID,
+		Amount:        adjustment.Amount,
+		Reason:        adjustment.Reason,
+		TicketRef:     adjustment.TicketRef,
+		ExpiresInDays: adjustment.ExpiresInDays,
+		Wallet:        adjustment.Wallet,
+		RequestedBy:   adjustment.OperatorID,
+		Status:        ApprovalStatusPending,
+		ExpiresAt:     expiresAt,
+	}
+}
+
+// Adjustment 승인 시 실행할 조정 (거래 내역에는 요청 운영자를 기록)
+func (a *AdjustmentApproval) Adjustment() *AdminAdjustment {
+	return &AdminAdjustment{
+		UserID:        a.UserID,
+		Amount:        a.Amount,
+		Reason:        a.Reason,
+		TicketRef:     a.TicketRef,
+		OperatorID:    a.RequestedBy,
+		ExpiresInDays: a.ExpiresInDays,
+		Wallet:        a.Wallet,
+	}
+}
+
+// IsPending 승인 대기 중인지 확인
+func (a *AdjustmentApproval) IsPending() bool {
+	return a.Status == ApprovalStatusPending
+}
+
+// IsExpired 승인 대기 시간이 지났는지 확인
+func (a *AdjustmentApproval) IsExpired(now time.Time) bool {
+	return now.After(a.ExpiresAt)
+}
+
+// Approve 승인 (요청 운영자 본인은 승인할 수 없음)
+func (a *AdjustmentApproval) Approve(operatorID, note string, now time.Time) error {
+	if err := a.checkReviewable(operatorID, now); err != nil {
+		return err
+	}
+	a.review(ApprovalStatusApproved, operatorID, note, now)
+	return nil
+}
+
+// Reject 반려 (요청 운영자 본인은 반려할 수 없음)
+func (a *AdjustmentApproval) Reject(operatorID, note string, now time.Time) error {
+	if err := a.checkReviewable(operatorID, now); err != nil {
+		return err
+	}
+	a.review(ApprovalStatusRejected, operatorID, note, now)
+	return nil
+}
+
+// Expire 승인 대기 시간 초과 처리
+func (a *AdjustmentApproval) Expire(now time.Time) {
+	a.Status = ApprovalStatusExpired
+	a.ReviewedAt = &now
+}
+
+// checkReviewable 승인/반려 가능 여부 확인
+func (a *AdjustmentApproval) checkReviewable(operatorID string, now time.Time) error {
+	if operatorID == "" {
+		return ErrOperatorRequired
+	}
+	if !a.IsPending() {
+		return ErrApprovalNotPending
+	}
+	if a.IsExpired(now) {
+		return ErrApprovalExpired
+	}
+	if operatorID == a.RequestedBy {
+		return ErrSelfApproval
+	}
+	return nil
+}
+
+// review 승인/반려 결과 기록
+func (a *AdjustmentApproval) review(status ApprovalStatus, operatorID, note string, now time.Time) {
+	a.Status = status
+	a.ReviewedBy = operatorID
+	a.ReviewNote = note
+	a.ReviewedAt = &now
+}
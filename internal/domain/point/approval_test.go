@@ -0,0 +1,68 @@
+package point
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAdjustmentApprovalReview(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		status     ApprovalStatus
+		expiresAt  time.Time
+		reviewer   string
+		approve    bool
+		wantErr    error
+		wantStatus ApprovalStatus
+	}{
+		{"approve by other operator", ApprovalStatusPending, now.Add(time.Hour), "bob", true, nil, ApprovalStatusApproved},
+		{"reject by other operator", ApprovalStatusPending, now.Add(time.Hour), "bob", false, nil, ApprovalStatusRejected},
+		{"self approval", ApprovalStatusPending, now.Add(time.Hour), "alice", true, ErrSelfApproval, ApprovalStatusPending},
+		{"self rejection", ApprovalStatusPending, now.Add(time.Hour), "alice", false, ErrSelfApproval, ApprovalStatusPending},
+		{"no operator", ApprovalStatusPending, now.Add(time.Hour), "", true, ErrOperatorRequired, ApprovalStatusPending},
+		{"already approved", ApprovalStatusApproved, now.Add(time.Hour), "bob", true, ErrApprovalNotPending, ApprovalStatusApproved},
+		{"already rejected", ApprovalStatusRejected, now.Add(time.Hour), "bob", false, ErrApprovalNotPending, ApprovalStatusRejected},
+		{"expired status", ApprovalStatusExpired, now.Add(time.Hour), "bob", true, ErrApprovalNotPending, ApprovalStatusExpired},
+		{"past ttl", ApprovalStatusPending, now.Add(-time.Second), "bob", true, ErrApprovalExpired, ApprovalStatusPending},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			approval := &AdjustmentApproval{
+				RequestedBy: "alice",
+				Status:      tt.status,
+				ExpiresAt:   tt.expiresAt,
+			}
+
+			var err error
+			if tt.approve {
+				err = approval.Approve(tt.reviewer, "ok", now)
+			} else {
+				err = approval.Reject(tt.reviewer, "no", now)
+			}
+			if err != tt.wantErr {
+				t.Fatalf("review error = %v, want %v", err, tt.wantErr)
+			}
+			if approval.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s", approval.Status, tt.wantStatus)
+			}
+			if err == nil && (approval.ReviewedBy != tt.reviewer || approval.ReviewedAt == nil) {
+				t.Errorf("review not recorded: reviewed by %q at %v", approval.ReviewedBy, approval.ReviewedAt)
+			}
+		})
+	}
+}
+
+func TestAdjustmentApprovalKeepsRequester(t *testing.T) {
+	adjustment := &AdminAdjustment{UserID: 1, Amount: 500000, Reason: "cs", TicketRef: "T-1", OperatorID: "alice"}
+	approval := NewAdjustmentApproval(AdjustmentDirectionCredit, adjustment, time.Now().Add(time.Hour))
+
+	if err := approval.Approve("bob", "", time.Now()); err != nil {
+		t.Fatalf("Approve() error = %v", err)
+	}
+	if got := approval.Adjustment().OperatorID; got != "alice" {
+		t.Errorf("executed adjustment operator = %q, want requester %q", got, "alice")
+	}
+}
@@ -0,0 +1,182 @@
+package point
+
+import (
+	"strings"
+	"time"
+)
+
+// CampaignBonusType 프로모션 추가 적립 방식
+type CampaignBonusType string
+
+const (
+	CampaignBonusMultiplier CampaignBonusType = "MULTIPLIER" // 기본 적립의 배수 (2 = 두 배, 기본 적립을 제외한 차이만 추가 적립)
+	CampaignBonusRate       CampaignBonusType = "RATE"       // 결제 금액 대비 추가 적립률 (0.03 = +3%)
+	CampaignBonusFlat       CampaignBonusType = "FLAT"       // 고정 포인트 추가 적립
+)
+
+// Campaign 기간 한정 프로모션 적립 캠페인
+type Campaign struct {
+	ID         int64
+	Name       string
+	BonusType  CampaignBonusType
+	BonusValue float64
+	StartsAt   time.Time
+	EndsAt     time.Time
+	Wallet     Wallet // 추가 적립 지갑 (비어 있으면 일반 포인트)
+
+	// 대상 조건 (비어 있으면 제한 없음)
+	Tiers            []Tier
+	ReasonTypes      []ReasonType
+	Categories       []string
+	MinPaymentAmount int64
+
+	// 예산 (0 이면 제한 없음)
+	UserBudget  int64 // 사용자별 최대 추가 적립
+	TotalBudget int64 // 캠페인 전체 최대 추가 적립
+	SpentBudget int64 // 지급한 추가 적립 누계
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// EarnContext 캠페인 대상 여부를 판단할 적립 정보
+type EarnContext struct {
+	UserID        int64
+	Tier          Tier
+	ReasonType    ReasonType
+	Categories    []string // 주문 상품 카테고리
+	PaymentAmount int64
+	BaseAmount    int64 // 기본 적립 포인트
+	At            time.Time
+}
+
+// Validate 캠페인 설정 검증
+func (c *Campaign) Validate() error {
+	if strings.TrimSpace(c.Name) == "" || !c.EndsAt.After(c.StartsAt) {
+		return ErrInvalidCampaign
+	}
+	if c.UserBudget < 0 || c.TotalBudget < 0 || c.MinPaymentAmount < 0 {
+		return ErrInvalidCampaign
+	}
+	switch c.BonusType {
+	case CampaignBonusMultiplier:
+		if c.BonusValue <= 1 {
+			return ErrInvalidCampaign
+		}
+	case CampaignBonusRate, CampaignBonusFlat:
+		if c.BonusValue <= 0 {
+			return ErrInvalidCampaign
+		}
+	default:
+		return ErrInvalidCampaign
+	}
+	for _, tier := range c.Tiers {
+		if !tier.IsValid() {
+			return ErrInvalidCampaign
+		}
+	}
+	if c.Wallet != "" && !c.Wallet.IsValid() {
+		return ErrInvalidCampaign
+	}
+	return nil
+}
+
+// IsActive 캠페인 기간인지 확인
+func (c *Campaign) IsActive(at time.Time) bool {
+	return !at.Before(c.StartsAt) && at.Before(c.EndsAt)
+}
+
+// IsEligible 적립이 캠페인 대상인지 확인
+func (c *Campaign) IsEligible(ec *EarnContext) bool {
+	if !c.IsActive(ec.At) || ec.PaymentAmount < c.MinPaymentAmount {
+		return false
+	}
+	if len(c.Tiers) > 0 && !containsTier(c.Tiers, ec.Tier) {
+		return false
+	}
+	if len(c.ReasonTypes) > 0 && !containsReasonType(c.ReasonTypes, ec.ReasonType) {
+		return false
+	}
+	if len(c.Categories) > 0 && !containsAnyCategory(c.Categories, ec.Categories) {
+		return false
+	}
+	return true
+}
+
+// CalculateBonus 예산 적용 전 추가 적립 포인트 계산
+func (c *Campaign) CalculateBonus(ec *EarnContext) int64 {
+	switch c.BonusType {
+	case CampaignBonusMultiplier:
+		return int64(float64(ec.BaseAmount) * (c.BonusValue - 1))
+	case CampaignBonusRate:
+		return int64(float64(ec.PaymentAmount) * c.BonusValue)
+	case CampaignBonusFlat:
+		return int64(c.BonusValue)
+	}
+	return 0
+}
+
+// CapBonus 사용자별/전체 예산 남은 금액으로 추가 적립 제한
+func (c *Campaign) CapBonus(bonus, userSpent int64) int64 {
+	if c.UserBudget > 0 && bonus > c.UserBudget-userSpent {
+		bonus = c.UserBudget - userSpent
+	}
+	if c.TotalBudget > 0 && bonus > c.TotalBudget-c.SpentBudget {
+		bonus = c.TotalBudget - c.SpentBudget
+	}
+	if bonus < 0 {
+		return 0
+	}
+	return bonus
+}
+
+// Spend 지급한 추가 적립을 예산에 반영
+func (c *Campaign) Spend(amount int64) {
+	c.SpentBudget += amount
+}
+
+// Release 취소/회수된 추가 적립을 예산에 돌려줌
+func (c *Campaign) Release(amount int64) {
+	c.SpentBudget -= amount
+	if c.SpentBudget < 0 {
+		c.SpentBudget = 0
+	}
+}
+
+func containsTier(tiers []Tier, tier Tier) bool {
+	for _, t := range tiers {
+		if t == tier {
+			return true
+		}
+	}
+	return false
+}
+
+func containsReasonType(reasonTypes []ReasonType, reasonType ReasonType) bool {
+	for _, r := range reasonTypes {
+		if r == reasonType {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAnyCategory(targets, categories []string) bool {
+	for _, target := range targets {
+		for _, category := range categories {
+			if strings.EqualFold(target, category) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CampaignCost 캠페인 추가 적립 비용 집계
+type CampaignCost struct {
+	CampaignID      int64
+	UserCount       int64 // 추가 적립을 받은 사용자 수
+	PendingAmount   int64 // 적립 예정 (구매 확정 대기)
+	ConfirmedAmount int64 // 확정된 추가 적립
+	CancelledAmount int64 // 환불 등으로 취소된 추가 적립
+}
@@ -23,4 +23,100 @@ var (
 
 	// ErrTransactionNotFound 거래 내역 없음
 	ErrTransactionNotFound = errors.New("transaction not found")
+
+	// ErrOrderAlreadyUsed 이미 포인트를 사용한 주문
+	ErrOrderAlreadyUsed = errors.New("points already used for order")
+
+	// ErrOrderAlreadyEarned 이미 구매 적립된 주문
+	ErrOrderAlreadyEarned = errors.New("points already earned for order")
+
+	// ErrReservationNotFound 포인트 예약 없음
+	ErrReservationNotFound = errors.New("reservation not found")
+
+	// ErrReservationExpired 유효시간이 지난 예약
+	ErrReservationExpired = errors.New("reservation expired")
+
+	// ErrOrderAlreadyRefunded 이미 환불 처리된 주문
+	ErrOrderAlreadyRefunded = errors.New("order already refunded")
+
+	// ErrOrderRefundNotFound 부분 환불 내역 없음
+	ErrOrderRefundNotFound = errors.New("order refund not found")
+
+	// ErrInvalidRefundAmount 잘못된 환불 금액
+	ErrInvalidRefundAmount = errors.New("invalid refund amount")
+
+	// ErrRefundExceedsPayment 남은 결제 금액을 초과하는 환불
+	ErrRefundExceedsPayment = errors.New("refund amount exceeds remaining payment")
+
+	// ErrRefundExceedsUsedPoints 남은 사용 포인트를 초과하는 복구
+	ErrRefundExceedsUsedPoints = errors.New("refund points exceed remaining used points")
+
+	// ErrRefundPaymentMismatch 이전 부분 환불과 원 결제 금액이 다름
+	ErrRefundPaymentMismatch = errors.New("payment amount does not match previous refunds")
+
+	// ErrInvalidUserID 잘못된 사용자 ID
+	ErrInvalidUserID = errors.New("invalid user id")
+
+	// ErrInvalidAdjustmentAmount 잘못된 관리자 조정 금액
+	ErrInvalidAdjustmentAmount = errors.New("invalid adjustment amount")
+
+	// ErrAdjustmentReasonRequired 관리자 조정 사유 누락
+	ErrAdjustmentReasonRequired = errors.New("adjustment reason is required")
+
+	// ErrTicketRefRequired 관리자 조정 티켓 번호 누락
+	ErrTicketRefRequired = errors.New("ticket reference is required")
+
+	// ErrOperatorRequired 처리 운영자 누락
+	ErrOperatorRequired = errors.New("operator id is required")
+
+	// ErrInvalidExpiryDays 허용 범위를 벗어난 유효기간
+	ErrInvalidExpiryDays = errors.New("invalid expiry days")
+
+	// ErrApprovalNotFound 승인 요청 없음
+	ErrApprovalNotFound = errors.New("approval not found")
+
+	// ErrApprovalNotPending 이미 처리된 승인 요청
+	ErrApprovalNotPending = errors.New("approval is not pending")
+
+	// ErrApprovalExpired 승인 대기 시간이 지난 승인 요청
+	ErrApprovalExpired = errors.New("approval expired")
+
+	// ErrSelfApproval 요청 운영자 본인의 승인/반려
+	ErrSelfApproval = errors.New("approval must be reviewed by a different operator")
+
+	// ErrCampaignNotFound 캠페인 없음
+	ErrCampaignNotFound = errors.New("campaign not found")
+
+	// ErrInvalidCampaign 잘못된 캠페인 설정
+	ErrInvalidCampaign = errors.New("invalid campaign")
+
+	// ErrInvalidLineItem 잘못된 주문 라인
+	ErrInvalidLineItem = errors.New("invalid line item")
+
+	// ErrOrderLineNotFound 주문 라인 없음
+	ErrOrderLineNotFound = errors.New("order line not found")
+
+	// ErrRefundExceedsQuantity 남은 수량을 초과하는 라인 환불
+	ErrRefundExceedsQuantity = errors.New("refund quantity exceeds remaining quantity")
+
+	// ErrInvalidWallet 알 수 없는 포인트 지갑
+	ErrInvalidWallet = errors.New("invalid wallet")
+
+	// ErrInvalidQuoteAmount 잘못된 견적 주문 금액/사용 포인트
+	ErrInvalidQuoteAmount = errors.New("invalid quote amount")
+
+	// ErrWebhookNotFound 웹훅 구독 없음
+	ErrWebhookNotFound = errors.New("webhook subscription not found")
+
+	// ErrInvalidWebhook 잘못된 웹훅 구독 설정
+	ErrInvalidWebhook = errors.New("invalid webhook subscription")
+
+	// ErrWebhookDeliveryNotFound 웹훅 전송 내역 없음
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+
+	// ErrInvalidWebhookSignature 웹훅 서명 불일치
+	ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+
+	// ErrWebhookSignatureExpired 허용 시간을 벗어난 웹훅 서명 시각 (재전송 공격 방지)
+	ErrWebhookSignatureExpired = errors.New("webhook signature timestamp out of tolerance")
 )
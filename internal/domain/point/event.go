@@ -0,0 +1,143 @@
+package point
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"time"
+)
+
+// EventType 포인트 도메인 이벤트 유형
+type EventType string
+
+const (
+	EventTypeEarned        EventType = "POINT_EARNED"         // 적립 (적립 예정 포함)
+	EventTypeEarnConfirmed EventType = "POINT_EARN_CONFIRMED" // 적립 예정 포인트 확정
+	EventTypeEarnCancelled EventType = "POINT_EARN_CANCELLED" // 환불로 인한 적립 취소/회수
+	EventTypeUsed          EventType = "POINT_USED"           // 사용 (예약 확정 포함)
+	EventTypeRefunded      EventType = "POINT_REFUNDED"       // 환불로 인한 사용 포인트 복구
+	EventTypeExpired       EventType = "POINT_EXPIRED"        // 유효기간 만료
+	EventTypeAdjusted      EventType = "POINT_ADJUSTED"       // 관리자 조정/정합성 보정
+)
+
+// EventTypes 전체 이벤트 유형
+var EventTypes = []EventType{
+	EventTypeEarned,
+	EventTypeEarnConfirmed,
+	EventTypeEarnCancelled,
+	EventTypeUsed,
+	EventTypeRefunded,
+	EventTypeExpired,
+	EventTypeAdjusted,
+}
+
+// IsValid 알려진 이벤트 유형인지 확인
+func (t EventType) IsValid() bool {
+	for _, eventType := range EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// OutboxStatus outbox 이벤트 발행 상태
+type OutboxStatus string
+
+const (
+	OutboxStatusPending   OutboxStatus = "PENDING"   // 발행 대기 (재시도 포함)
+	OutboxStatusPublished OutboxStatus = "PUBLISHED" // 발행 완료
+	OutboxStatusFailed    OutboxStatus = "FAILED"    // 최대 시도 횟수 초과로 발행 보류
+)
+
+// OutboxEvent 트랜잭션 outbox 에 저장된 도메인 이벤트
+// 포인트 거래와 같은 DB 트랜잭션에 저장되고, Worker 가 커밋된 이벤트만 발행
+type OutboxEvent struct {
+	ID            int64
+	EventID       string // 이벤트 고유 ID (구독자 중복 제거용)
+	EventType     EventType
+	UserID        int64  // 사용자별 발행 순서 기준
+	TransactionID *int64 // 이벤트를 만든 거래 ID
+	Payload       []byte // JSON 페이로드
+	Status        OutboxStatus
+	Attempts      int       // 발행 시도 횟수
+	NextAttemptAt time.Time // 다음 발행 시도 시각
+	LastError     string
+	PublishedAt   *time.Time
+	CreatedAt     time.Time
+}
+
+// NewOutboxEvent 발행 대기 이벤트 생성
+func NewOutboxEvent(eventType EventType, userID int64, transactionID *int64, now time.Time) *OutboxEvent {
+	return &OutboxEvent{
+		EventID:       newEventID(),
+		EventType:     eventType,
+		UserID:        userID,
+		TransactionID: transactionID,
+		Status:        OutboxStatusPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+}
+
+// MarkPublished 발행 완료 처리
+func (e *OutboxEvent) MarkPublished(now time.Time) {
+	e.Attempts++
+	e.Status = OutboxStatusPublished
+	e.LastError = ""
+	e.PublishedAt = &now
+}
+
+// MarkFailed 발행 실패 처리 (재시도 정책에 따라 다음 시도 시각 설정, 최대 시도 횟수를 넘으면 보류)
+func (e *OutboxEvent) MarkFailed(cause error, now time.Time, policy DispatchPolicy) {
+	e.Attempts++
+	e.LastError = cause.Error()
+	if policy.MaxAttempts > 0 && e.Attempts >= policy.MaxAttempts {
+		e.Status = OutboxStatusFailed
+		return
+	}
+	e.NextAttemptAt = now.Add(policy.Backoff(e.Attempts))
+}
+
+// DispatchPolicy 이벤트 발행 재시도 정책
+type DispatchPolicy struct {
+	MaxAttempts int           // 최대 발행 시도 횟수 (넘으면 FAILED 로 보류, 0 이면 무제한)
+	BaseBackoff time.Duration // 첫 재시도 대기 시간 (재시도마다 2배)
+	MaxBackoff  time.Duration // 최대 대기 시간
+}
+
+// DefaultDispatchPolicy 기본 이벤트 발행 재시도 정책
+func DefaultDispatchPolicy() DispatchPolicy {
+	return DispatchPolicy{
+		MaxAttempts: 10,
+		BaseBackoff: 5 * time.Second,
+		MaxBackoff:  10 * time.Minute,
+	}
+}
+
+// Backoff attempts 번 실패한 뒤 다음 시도까지 대기 시간 (지수 증가)
+func (p DispatchPolicy) Backoff(attempts int) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+	d := p.BaseBackoff << uint(attempts-1)
+	if d <= 0 || d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	return d
+}
+
+// EventPublisher 도메인 이벤트 발행자 인터페이스
+type EventPublisher interface {
+	// Publish 이벤트 1건 발행 (실패하면 재시도되므로 구독자는 EventID 로 중복 제거)
+	Publish(ctx context.Context, event *OutboxEvent) error
+}
+
+// newEventID 무작위 UUID(v4) 형식 이벤트 ID 생성
+func newEventID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
@@ -0,0 +1,29 @@
+package point
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDispatchPolicyBackoff(t *testing.T) {
+	policy := DispatchPolicy{MaxAttempts: 5, BaseBackoff: time.Second, MaxBackoff: 10 * time.Second}
+
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{0, time.Second},
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{3, 4 * time.Second},
+		{4, 8 * time.Second},
+		{5, 10 * time.Second},
+		{100, 10 * time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := policy.Backoff(tt.attempts); got != tt.want {
+			t.Errorf("Backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
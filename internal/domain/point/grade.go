@@ -0,0 +1,55 @@
+package point
+
+import "time"
+
+// TierThreshold 등급별 최소 구매 실적
+type TierThreshold struct {
+	Tier     Tier
+	MinSpend int64
+}
+
+// GradePolicy 구매 실적 기반 회원 등급 산정 정책
+type GradePolicy struct {
+	WindowMonths       int             // 구매 실적 집계 기간 (최근 N 개월)
+	Thresholds         []TierThreshold // 등급별 최소 구매 실적 (상위 등급부터)
+	DowngradeGraceDays int             // 하향 유예 기간 (일)
+	UpgradeBonuses     map[Tier]int64  // 승급 보너스 포인트 (등급별 최초 1회)
+}
+
+// NewDefaultGradePolicy 기본 등급 산정 정책 생성
+func NewDefaultGradePolicy() *GradePolicy {
+	return &GradePolicy{
+		WindowMonths: 12,
+		Thresholds: []TierThreshold{
+			{Tier: TierVIP, MinSpend: 3000000},
+			{Tier: TierGold, MinSpend: 1000000},
+			{Tier: TierSilver, MinSpend: 300000},
+		},
+		DowngradeGraceDays: 30,
+		UpgradeBonuses: map[Tier]int64{
+			TierSilver: 1000,
+			TierGold:   3000,
+			TierVIP:    10000,
+		},
+	}
+}
+
+// TierFor 구매 실적에 해당하는 등급
+func (p *GradePolicy) TierFor(spendAmount int64) Tier {
+	for _, threshold := range p.Thresholds {
+		if spendAmount >= threshold.MinSpend {
+			return threshold.Tier
+		}
+	}
+	return DefaultTier
+}
+
+// WindowStart 구매 실적 집계 시작 시각
+func (p *GradePolicy) WindowStart(now time.Time) time.Time {
+	return now.AddDate(0, -p.WindowMonths, 0)
+}
+
+// UpgradeBonus 등급 승급 보너스 포인트
+func (p *GradePolicy) UpgradeBonus(tier Tier) int64 {
+	return p.UpgradeBonuses[tier]
+}
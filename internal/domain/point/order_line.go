@@ -0,0 +1,187 @@
+package point
+
+import (
+	"strings"
+	"time"
+)
+
+// LineItem 주문 상품 라인
+type LineItem struct {
+	LineNo        int    // 주문 내 라인 번호 (1부터)
+	SKU           string // 상품 코드
+	Category      string // 상품 카테고리
+	Quantity      int64
+	UnitPrice     int64
+	Discount      int64 // 라인 할인 금액
+	PointEligible bool  // 포인트 적립 대상 여부 (상품권 등은 false)
+}
+
+// Amount 라인 결제 대상 금액 (수량 × 단가 - 할인)
+func (l *LineItem) Amount() int64 {
+	amount := l.Quantity*l.UnitPrice - l.Discount
+	if amount < 0 {
+		return 0
+	}
+	return amount
+}
+
+// Validate 라인 검증
+func (l *LineItem) Validate() error {
+	if l.LineNo <= 0 || l.Quantity <= 0 || l.UnitPrice < 0 || l.Discount < 0 {
+		return ErrInvalidLineItem
+	}
+	return nil
+}
+
+// ValidateLineItems 주문 라인 목록 검증 (라인 번호 중복 불가)
+func ValidateLineItems(items []LineItem) error {
+	seen := make(map[int]bool, len(items))
+	for i := range items {
+		if err := items[i].Validate(); err != nil {
+			return err
+		}
+		if seen[items[i].LineNo] {
+			return ErrInvalidLineItem
+		}
+		seen[items[i].LineNo] = true
+	}
+	return nil
+}
+
+// LineCategories 주문 라인의 상품 카테고리 목록
+func LineCategories(items []LineItem) []string {
+	var categories []string
+	for _, item := range items {
+		if item.Category != "" {
+			categories = append(categories, item.Category)
+		}
+	}
+	return categories
+}
+
+// PurchaseOrder 구매 적립 대상 주문
+// Items 가 있으면 라인별로 적립을 계산하고, 없으면 결제 금액 기준으로 계산
+type PurchaseOrder struct {
+	OrderID       int64
+	PaymentAmount int64
+	Categories    []string // 주문 상품 카테고리 (비어 있으면 Items 의 카테고리 사용)
+	Items         []LineItem
+}
+
+// EarnCategories 카테고리 대상 캠페인 판단에 사용할 상품 카테고리
+func (o *PurchaseOrder) EarnCategories() []string {
+	if len(o.Categories) > 0 {
+		return o.Categories
+	}
+	return LineCategories(o.Items)
+}
+
+// OrderLine 구매 적립 시 저장한 주문 라인별 적립 내역 (부분 환불 시 라인 단위 회수 기준)
+type OrderLine struct {
+	ID      int64
+	OrderID int64
+	UserID  int64
+	LineItem
+	EarnRate         float64 // 적용된 적립률
+	EarnedPoints     int64   // 라인 적립 포인트
+	RefundedQuantity int64   // 누적 환불 수량
+	ClawedBackPoints int64   // 누적 회수 포인트
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// RemainingQuantity 환불되지 않은 수량
+func (l *OrderLine) RemainingQuantity() int64 {
+	return l.Quantity - l.RefundedQuantity
+}
+
+// Refund quantity 만큼 환불하고 회수할 적립 포인트 반환
+// 남은 수량을 모두 환불하면 남은 적립 포인트 전액을 회수
+func (l *OrderLine) Refund(quantity int64) (int64, error) {
+	if quantity <= 0 || quantity > l.RemainingQuantity() {
+		return 0, ErrRefundExceedsQuantity
+	}
+
+	var clawback int64
+	if quantity == l.RemainingQuantity() {
+		clawback = l.EarnedPoints - l.ClawedBackPoints
+	} else {
+		clawback = l.EarnedPoints * quantity / l.Quantity
+	}
+
+	l.RefundedQuantity += quantity
+	l.ClawedBackPoints += clawback
+	return clawback, nil
+}
+
+// RefundAmount quantity 만큼 환불할 때의 라인 결제 대상 금액
+func (l *OrderLine) RefundAmount(quantity int64) int64 {
+	return l.Amount() * quantity / l.Quantity
+}
+
+// RefundLine 부분 환불 라인 (라인 번호, 환불 수량)
+type RefundLine struct {
+	LineNo   int
+	Quantity int64
+}
+
+// EarnRuleScope 적립 규칙 적용 범위
+type EarnRuleScope string
+
+const (
+	EarnRuleScopeCategory EarnRuleScope = "CATEGORY" // 상품 카테고리
+	EarnRuleScopeSKU      EarnRuleScope = "SKU"      // 개별 상품
+)
+
+// EarnRule 카테고리/상품별 적립 규칙
+type EarnRule struct {
+	Scope    EarnRuleScope
+	Target   string   // 카테고리 또는 SKU
+	EarnRate *float64 // 적립률 (nil 이면 회원 등급 적립률)
+	Excluded bool     // 적립 제외 여부
+}
+
+// EarnRuleSet 적립 규칙 모음 (SKU 규칙이 카테고리 규칙보다 우선)
+type EarnRuleSet struct {
+	bySKU      map[string]*EarnRule
+	byCategory map[string]*EarnRule
+}
+
+// NewEarnRuleSet 적립 규칙 모음 생성
+func NewEarnRuleSet(rules []*EarnRule) *EarnRuleSet {
+	set := &EarnRuleSet{
+		bySKU:      make(map[string]*EarnRule),
+		byCategory: make(map[string]*EarnRule),
+	}
+	for _, rule := range rules {
+		switch rule.Scope {
+		case EarnRuleScopeSKU:
+			set.bySKU[rule.Target] = rule
+		case EarnRuleScopeCategory:
+			set.byCategory[strings.ToLower(rule.Target)] = rule
+		}
+	}
+	return set
+}
+
+// RateFor 라인에 적용할 적립률 (적립 대상이 아니면 0)
+func (s *EarnRuleSet) RateFor(item *LineItem, defaultRate float64) float64 {
+	if !item.PointEligible {
+		return 0
+	}
+
+	rule := s.bySKU[item.SKU]
+	if rule == nil {
+		rule = s.byCategory[strings.ToLower(item.Category)]
+	}
+	if rule == nil {
+		return defaultRate
+	}
+	if rule.Excluded {
+		return 0
+	}
+	if rule.EarnRate != nil {
+		return *rule.EarnRate
+	}
+	return defaultRate
+}
@@ -7,9 +7,14 @@ type UserPoint struct {
 	UserID           int64
 	AvailableBalance int64 // 사용 가능 포인트
 	PendingBalance   int64 // 적립 예정 포인트
+	HeldBalance      int64 // 결제 대기 중 예약된 포인트
+	DebtBalance      int64 // 회수하지 못한 포인트 부채 (이후 적립에서 먼저 상계)
 	TotalEarned      int64 // 누적 적립
 	TotalUsed        int64 // 누적 사용
 	UpdatedAt        time.Time
+
+	// WalletBalances 지갑별 사용 가능 포인트 (적립 lot 잔여 포인트 합계, 잔액 조회 시에만 채움)
+	WalletBalances map[Wallet]int64
 }
 
 // CanUse 사용 가능 여부 확인
@@ -31,11 +36,52 @@ func (up *UserPoint) Use(amount int64) error {
 	return nil
 }
 
-// Earn 포인트 적립
-func (up *UserPoint) Earn(amount int64) {
-	up.AvailableBalance += amount
+// Deduct 관리자 차감 (사용 가능 포인트 내에서만 차감, 누적 사용에는 반영하지 않음)
+func (up *UserPoint) Deduct(amount int64) error {
+	if err := up.CanUse(amount); err != nil {
+		return err
+	}
+	up.AvailableBalance -= amount
+	up.UpdatedAt = time.Now()
+	return nil
+}
+
+// Hold 포인트 예약 (사용 가능 포인트에서 예약 포인트로 이동)
+func (up *UserPoint) Hold(amount int64) error {
+	if err := up.CanUse(amount); err != nil {
+		return err
+	}
+	up.AvailableBalance -= amount
+	up.HeldBalance += amount
+	up.UpdatedAt = time.Now()
+	return nil
+}
+
+// CaptureHold 예약 포인트 사용 확정
+func (up *UserPoint) CaptureHold(amount int64) {
+	if up.HeldBalance >= amount {
+		up.HeldBalance -= amount
+		up.TotalUsed += amount
+		up.UpdatedAt = time.Now()
+	}
+}
+
+// ReleaseHold 예약 포인트 해제 (사용 가능 포인트로 복구)
+func (up *UserPoint) ReleaseHold(amount int64) {
+	if up.HeldBalance >= amount {
+		up.HeldBalance -= amount
+		up.AvailableBalance += amount
+		up.UpdatedAt = time.Now()
+	}
+}
+
+// Earn 포인트 적립 (부채가 있으면 먼저 상계하고 상계 금액 반환)
+func (up *UserPoint) Earn(amount int64) int64 {
+	offset := up.offsetDebt(amount)
+	up.AvailableBalance += amount - offset
 	up.TotalEarned += amount
 	up.UpdatedAt = time.Now()
+	return offset
 }
 
 // AddPending 적립 예정 포인트 추가
@@ -44,12 +90,23 @@ func (up *UserPoint) AddPending(amount int64) {
 	up.UpdatedAt = time.Now()
 }
 
-// ConfirmPending 적립 예정 포인트를 실제 적립으로 전환
-func (up *UserPoint) ConfirmPending(amount int64) {
+// ConfirmPending 적립 예정 포인트를 실제 적립으로 전환 (부채가 있으면 먼저 상계하고 상계 금액 반환)
+func (up *UserPoint) ConfirmPending(amount int64) int64 {
+	if up.PendingBalance < amount {
+		return 0
+	}
+	offset := up.offsetDebt(amount)
+	up.PendingBalance -= amount
+	up.AvailableBalance += amount - offset
+	up.TotalEarned += amount
+	up.UpdatedAt = time.Now()
+	return offset
+}
+
+// CancelPending 적립 예정 포인트 취소
+func (up *UserPoint) CancelPending(amount int64) {
 	if up.PendingBalance >= amount {
 		up.PendingBalance -= amount
-		up.AvailableBalance += amount
-		up.TotalEarned += amount
 		up.UpdatedAt = time.Now()
 	}
 }
@@ -60,10 +117,45 @@ func (up *UserPoint) Refund(amount int64) {
 	up.UpdatedAt = time.Now()
 }
 
-// Expire 포인트 만료
-func (up *UserPoint) Expire(amount int64) {
-	if up.AvailableBalance >= amount {
-		up.AvailableBalance -= amount
-		up.UpdatedAt = time.Now()
+// ClawBack 적립 포인트 회수 (사용 가능 포인트가 부족하면 부족분을 부채로 기록하고 부채 발생 금액 반환)
+func (up *UserPoint) ClawBack(amount int64) int64 {
+	deducted := amount
+	if deducted > up.AvailableBalance {
+		deducted = up.AvailableBalance
 	}
+	debt := amount - deducted
+	up.AvailableBalance -= deducted
+	up.DebtBalance += debt
+	up.UpdatedAt = time.Now()
+	return debt
+}
+
+// NetBalance 순잔액 (사용 가능 포인트 - 부채)
+func (up *UserPoint) NetBalance() int64 {
+	return up.AvailableBalance - up.DebtBalance
+}
+
+// HasDebt 포인트 부채 여부
+func (up *UserPoint) HasDebt() bool {
+	return up.DebtBalance > 0
+}
+
+// offsetDebt 적립 포인트로 부채 상계 후 상계 금액 반환
+func (up *UserPoint) offsetDebt(amount int64) int64 {
+	offset := amount
+	if offset > up.DebtBalance {
+		offset = up.DebtBalance
+	}
+	up.DebtBalance -= offset
+	return offset
+}
+
+// Expire 포인트 만료 (만료할 lot 잔여 포인트보다 사용 가능 포인트가 적으면 잔액과 거래 내역이 어긋난 것이므로 오류)
+func (up *UserPoint) Expire(amount int64) error {
+	if up.AvailableBalance < amount {
+		return ErrInsufficientPoints
+	}
+	up.AvailableBalance -= amount
+	up.UpdatedAt = time.Now()
+	return nil
 }
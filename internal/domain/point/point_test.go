@@ -0,0 +1,119 @@
+package point
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUserPointReservation(t *testing.T) {
+	tests := []struct {
+		name          string
+		available     int64
+		hold          int64
+		capture       bool
+		wantErr       error
+		wantAvailable int64
+		wantHeld      int64
+		wantUsed      int64
+	}{
+		{"hold then capture", 5000, 3000, true, nil, 2000, 0, 3000},
+		{"hold then release", 5000, 3000, false, nil, 5000, 0, 0},
+		{"hold whole balance", 3000, 3000, true, nil, 0, 0, 3000},
+		{"insufficient", 2000, 3000, true, ErrInsufficientPoints, 2000, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			up := &UserPoint{AvailableBalance: tt.available}
+
+			err := up.Hold(tt.hold)
+			if err != tt.wantErr {
+				t.Fatalf("Hold() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil {
+				if up.HeldBalance != tt.hold {
+					t.Fatalf("HeldBalance after hold = %d, want %d", up.HeldBalance, tt.hold)
+				}
+				if tt.capture {
+					up.CaptureHold(tt.hold)
+				} else {
+					up.ReleaseHold(tt.hold)
+				}
+			}
+
+			if up.AvailableBalance != tt.wantAvailable || up.HeldBalance != tt.wantHeld || up.TotalUsed != tt.wantUsed {
+				t.Errorf("balance = available %d held %d used %d, want %d %d %d",
+					up.AvailableBalance, up.HeldBalance, up.TotalUsed, tt.wantAvailable, tt.wantHeld, tt.wantUsed)
+			}
+		})
+	}
+}
+
+func TestReservationIsExpired(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		expiresAt time.Time
+		want      bool
+	}{
+		{"before ttl", now.Add(time.Minute), false},
+		{"at ttl", now, false},
+		{"after ttl", now.Add(-time.Minute), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Reservation{Status: ReservationStatusHeld, ExpiresAt: tt.expiresAt}
+			if got := r.IsExpired(now); got != tt.want {
+				t.Errorf("IsExpired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUserPointClawBackAndEarn(t *testing.T) {
+	tests := []struct {
+		name          string
+		available     int64
+		clawBack      int64
+		earn          int64
+		wantDebt      int64
+		wantOffset    int64
+		wantAvailable int64
+	}{
+		{"covered by balance", 1000, 400, 0, 0, 0, 600},
+		{"debt then offset", 300, 1000, 500, 200, 500, 0},
+		{"debt fully offset", 0, 500, 800, 0, 500, 300},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			up := &UserPoint{AvailableBalance: tt.available}
+
+			up.ClawBack(tt.clawBack)
+			offset := up.Earn(tt.earn)
+
+			if offset != tt.wantOffset {
+				t.Errorf("Earn() offset = %d, want %d", offset, tt.wantOffset)
+			}
+			if up.DebtBalance != tt.wantDebt || up.AvailableBalance != tt.wantAvailable {
+				t.Errorf("debt %d available %d, want %d %d", up.DebtBalance, up.AvailableBalance, tt.wantDebt, tt.wantAvailable)
+			}
+		})
+	}
+}
+
+func TestUserPointExpire(t *testing.T) {
+	up := &UserPoint{AvailableBalance: 500}
+
+	if err := up.Expire(300); err != nil || up.AvailableBalance != 200 {
+		t.Fatalf("Expire(300) = %v, available %d", err, up.AvailableBalance)
+	}
+	if err := up.Expire(300); err != ErrInsufficientPoints {
+		t.Errorf("Expire() over balance error = %v, want %v", err, ErrInsufficientPoints)
+	}
+	if up.AvailableBalance != 200 {
+		t.Errorf("failed Expire() changed balance to %d", up.AvailableBalance)
+	}
+}
@@ -1,22 +1,68 @@
 package point
 
-import "time"
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// RoundingMode 적립 포인트 원 단위 처리 방식
+type RoundingMode string
+
+const (
+	RoundingFloor RoundingMode = "FLOOR" // 내림
+	RoundingRound RoundingMode = "ROUND" // 반올림
+	RoundingCeil  RoundingMode = "CEIL"  // 올림
+)
+
+// ParseRoundingMode 원 단위 처리 방식 이름을 RoundingMode 로 변환 (비어 있으면 내림)
+func ParseRoundingMode(name string) (RoundingMode, error) {
+	switch mode := RoundingMode(strings.ToUpper(strings.TrimSpace(name))); mode {
+	case "":
+		return RoundingFloor, nil
+	case RoundingFloor, RoundingRound, RoundingCeil:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unknown rounding mode: %s", name)
+	}
+}
+
+// UseLimit 최대 사용 가능 포인트를 제한하는 사유
+type UseLimit string
+
+const (
+	UseLimitBalance      UseLimit = "BALANCE"        // 보유 포인트
+	UseLimitMaxUseRate   UseLimit = "MAX_USE_RATE"   // 최대 사용 비율
+	UseLimitMinPayment   UseLimit = "MIN_PAYMENT"    // 최소 결제 금액
+	UseLimitMinUseAmount UseLimit = "MIN_USE_AMOUNT" // 최소 사용 금액 미만 (사용 불가)
+)
 
 // Policy 포인트 정책
 type Policy struct {
-	EarnRate          float64 // 적립률 (0.05 = 5%)
-	ReviewTextPoints  int64   // 텍스트 리뷰 적립
-	ReviewPhotoPoints int64   // 포토 리뷰 적립
-	SignupBonus       int64   // 가입 보너스
-	MinOrderAmount    int64   // 최소 주문 금액
-	MaxEarnPerOrder   int64   // 주문당 최대 적립
-	ExpiryMonths      int     // 유효기간 (월)
-	EarnDelayDays     int     // 적립 지연 일수
+	EarnRate          float64      // 적립률 (0.05 = 5%)
+	ReviewTextPoints  int64        // 텍스트 리뷰 적립
+	ReviewPhotoPoints int64        // 포토 리뷰 적립
+	SignupBonus       int64        // 가입 보너스
+	MinOrderAmount    int64        // 최소 주문 금액
+	MaxEarnPerOrder   int64        // 주문당 최대 적립
+	ExpiryMonths      int          // 유효기간 (월)
+	EarnDelayDays     int          // 적립 지연 일수
+	EarnRounding      RoundingMode // 라인별 적립 포인트 원 단위 처리
+	EarnOnCashOnly    bool         // 포인트로 결제한 금액을 빼고 현금 결제분에만 적립
 
 	MinUseAmount     int64   // 최소 사용 금액
 	UseUnit          int64   // 사용 단위
 	MaxUseRate       float64 // 최대 사용 비율 (0.5 = 50%)
 	MinPaymentAmount int64   // 최소 결제 금액
+	HoldMinutes      int     // 포인트 예약 유효시간 (분)
+
+	WalletRules    map[Wallet]WalletRule // 지갑별 유효기간 (없는 지갑은 ExpiryMonths)
+	WalletUseOrder []Wallet              // 포인트 사용/회수 시 지갑 차감 순서
+
+	MaxAdminGrantExpiryDays int   // 관리자 지급 포인트 최대 유효기간 (일)
+	AdminApprovalThreshold  int64 // 이 금액을 넘는 관리자 조정은 다른 운영자 승인 필요 (0 이면 승인 없음)
+	AdminApprovalTTLHours   int   // 관리자 조정 승인 요청 유효시간 (시간)
 }
 
 // NewDefaultPolicy 기본 정책 생성
@@ -30,12 +76,43 @@ func NewDefaultPolicy() *Policy {
 		MaxEarnPerOrder:   50000,
 		ExpiryMonths:      12,
 		EarnDelayDays:     7,
+		EarnRounding:      RoundingFloor,
+		EarnOnCashOnly:    true,
 
 		MinUseAmount:     1000,
 		UseUnit:          100,
 		MaxUseRate:       0.5, // 50%
 		MinPaymentAmount: 1000,
+		HoldMinutes:      30,
+
+		WalletRules: map[Wallet]WalletRule{
+			WalletEvent: {ExpiryDays: 30},
+			WalletCash:  {NeverExpires: true},
+		},
+		WalletUseOrder: []Wallet{WalletEvent, WalletRegular, WalletCash},
+
+		MaxAdminGrantExpiryDays: 730,
+		AdminApprovalThreshold:  100000,
+		AdminApprovalTTLHours:   72,
+	}
+}
+
+// ForTier 회원 등급별 재정의를 적용한 정책 반환 (원본 정책은 변경하지 않음)
+func (p *Policy) ForTier(tierPolicy *TierPolicy) *Policy {
+	effective := *p
+	if tierPolicy == nil {
+		return &effective
+	}
+	if tierPolicy.EarnRate != nil {
+		effective.EarnRate = *tierPolicy.EarnRate
+	}
+	if tierPolicy.MaxEarnPerOrder != nil {
+		effective.MaxEarnPerOrder = *tierPolicy.MaxEarnPerOrder
+	}
+	if tierPolicy.MaxUseRate != nil {
+		effective.MaxUseRate = *tierPolicy.MaxUseRate
 	}
+	return &effective
 }
 
 // CalculateEarnPoints 적립 포인트 계산
@@ -47,6 +124,103 @@ func (p *Policy) CalculateEarnPoints(paymentAmount int64) int64 {
 	return earnPoints
 }
 
+// EarnableAmount 적립 대상 결제 금액 (현금 결제분만 적립하면 주문에 사용한 포인트 제외)
+func (p *Policy) EarnableAmount(paymentAmount, usedPoints int64) int64 {
+	if !p.EarnOnCashOnly {
+		return paymentAmount
+	}
+	if usedPoints >= paymentAmount {
+		return 0
+	}
+	return paymentAmount - usedPoints
+}
+
+// LineEarn 주문 라인별 적립 계산 결과
+type LineEarn struct {
+	Item   LineItem
+	Rate   float64
+	Points int64
+}
+
+// CalculateLineEarnPoints 주문 라인별 적립 포인트 계산
+// 라인마다 카테고리/상품 규칙의 적립률로 계산해 원 단위 처리하고,
+// 합계가 주문당 최대 적립을 넘으면 라인 적립 비율대로 줄임
+// 현금 결제분에만 적립하면 주문에 사용한 포인트(usedPoints)를 라인 금액 비율로 나눠 라인 금액에서 제외
+func (p *Policy) CalculateLineEarnPoints(items []LineItem, rules *EarnRuleSet, usedPoints int64) []LineEarn {
+	var itemsAmount int64
+	for i := range items {
+		itemsAmount += items[i].Amount()
+	}
+
+	earns := make([]LineEarn, len(items))
+	var total int64
+	for i := range items {
+		amount := items[i].Amount()
+		if p.EarnOnCashOnly {
+			amount -= proportionalPoints(usedPoints, amount, itemsAmount)
+		}
+		rate := rules.RateFor(&items[i], p.EarnRate)
+		points := p.roundPoints(float64(amount) * rate)
+		earns[i] = LineEarn{Item: items[i], Rate: rate, Points: points}
+		total += points
+	}
+
+	if total <= p.MaxEarnPerOrder {
+		return earns
+	}
+
+	// 최대 적립 초과분을 라인 비율로 차감 (내림 후 남는 포인트는 앞 라인부터 1P 씩 배분)
+	var capped int64
+	for i := range earns {
+		earns[i].Points = proportionalPoints(earns[i].Points, p.MaxEarnPerOrder, total)
+		capped += earns[i].Points
+	}
+	for i := 0; capped < p.MaxEarnPerOrder && i < len(earns); i++ {
+		if earns[i].Points > 0 {
+			earns[i].Points++
+			capped++
+		}
+	}
+	return earns
+}
+
+// roundPoints 적립 포인트 원 단위 처리
+func (p *Policy) roundPoints(points float64) int64 {
+	switch p.EarnRounding {
+	case RoundingRound:
+		return int64(math.Round(points))
+	case RoundingCeil:
+		return int64(math.Ceil(points))
+	default:
+		return int64(math.Floor(points))
+	}
+}
+
+// CalculateRefundPoints 부분 환불 시 복구할 사용 포인트 계산 (환불 결제 금액 비율)
+func (p *Policy) CalculateRefundPoints(usedPoints, paymentAmount, refundPaymentAmount int64) int64 {
+	return proportionalPoints(usedPoints, refundPaymentAmount, paymentAmount)
+}
+
+// CalculateEarnClawback 부분 환불 후 남은 결제 금액 비율만큼만 적립을 유지하고 회수할 포인트 계산
+func (p *Policy) CalculateEarnClawback(earnedPoints, retainedPoints, paymentAmount, remainingPaymentAmount int64) int64 {
+	keep := proportionalPoints(earnedPoints, remainingPaymentAmount, paymentAmount)
+	if retainedPoints <= keep {
+		return 0
+	}
+	return retainedPoints - keep
+}
+
+// proportionalPoints points * part / whole (원 단위 내림)
+func proportionalPoints(points, part, whole int64) int64 {
+	if whole <= 0 || part <= 0 {
+		return 0
+	}
+	if part >= whole {
+		return points
+	}
+	return int64(float64(points) * float64(part) / float64(whole))
+}
+
 // ValidateUse 사용 유효성 검증
 func (p *Policy) ValidateUse(useAmount, orderAmount, availableBalance int64) error {
 	// 최소 사용 금액 체크
@@ -79,12 +253,71 @@ func (p *Policy) ValidateUse(useAmount, orderAmount, availableBalance int64) err
 	return nil
 }
 
+// MaxUsableAmount ValidateUse 를 통과하는 최대 사용 포인트와 이를 제한하는 사유 계산
+// 보유 포인트/최대 사용 비율/최소 결제 금액 중 가장 작은 한도를 사용 단위로 내림하고,
+// 최소 사용 금액에 못 미치면 0 을 반환
+func (p *Policy) MaxUsableAmount(orderAmount, availableBalance int64) (int64, UseLimit) {
+	maxAmount, limit := availableBalance, UseLimitBalance
+
+	if rateLimit := int64(float64(orderAmount) * p.MaxUseRate); rateLimit < maxAmount {
+		maxAmount, limit = rateLimit, UseLimitMaxUseRate
+	}
+	if paymentLimit := orderAmount - p.MinPaymentAmount; paymentLimit < maxAmount {
+		maxAmount, limit = paymentLimit, UseLimitMinPayment
+	}
+
+	if p.UseUnit > 0 && maxAmount > 0 {
+		maxAmount -= maxAmount % p.UseUnit
+	}
+	if maxAmount < p.MinUseAmount {
+		return 0, UseLimitMinUseAmount
+	}
+	return maxAmount, limit
+}
+
 // CalculateExpiryDate 만료일 계산
 func (p *Policy) CalculateExpiryDate(earnedAt time.Time) time.Time {
 	return earnedAt.AddDate(0, p.ExpiryMonths, 0)
 }
 
+// CalculateWalletExpiry 지갑별 만료일 계산 (만료 없는 지갑이면 nil)
+func (p *Policy) CalculateWalletExpiry(wallet Wallet, earnedAt time.Time) *time.Time {
+	rule, ok := p.WalletRules[wallet.OrDefault()]
+	if !ok {
+		expiresAt := p.CalculateExpiryDate(earnedAt)
+		return &expiresAt
+	}
+	return rule.ExpiryDate(earnedAt)
+}
+
 // CalculateEarnDate 실제 적립일 계산 (구매 확정 후 지연 일수)
 func (p *Policy) CalculateEarnDate(confirmedAt time.Time) time.Time {
 	return confirmedAt.AddDate(0, 0, p.EarnDelayDays)
 }
+
+// CalculateHoldExpiry 포인트 예약 만료 시각 계산
+func (p *Policy) CalculateHoldExpiry(heldAt time.Time) time.Time {
+	return heldAt.Add(time.Duration(p.HoldMinutes) * time.Minute)
+}
+
+// CalculateAdminGrantExpiry 관리자 지급 포인트 만료일 계산 (days 가 0 이면 지갑별 유효기간, 만료 없으면 nil)
+func (p *Policy) CalculateAdminGrantExpiry(wallet Wallet, grantedAt time.Time, days int) (*time.Time, error) {
+	if days == 0 {
+		return p.CalculateWalletExpiry(wallet, grantedAt), nil
+	}
+	if days < 0 || days > p.MaxAdminGrantExpiryDays {
+		return nil, ErrInvalidExpiryDays
+	}
+	expiresAt := grantedAt.AddDate(0, 0, days)
+	return &expiresAt, nil
+}
+
+// RequiresAdminApproval 다른 운영자의 승인이 필요한 관리자 조정 금액인지 확인
+func (p *Policy) RequiresAdminApproval(amount int64) bool {
+	return p.AdminApprovalThreshold > 0 && amount > p.AdminApprovalThreshold
+}
+
+// CalculateApprovalExpiry 관리자 조정 승인 요청 만료 시각 계산
+func (p *Policy) CalculateApprovalExpiry(requestedAt time.Time) time.Time {
+	return requestedAt.Add(time.Duration(p.AdminApprovalTTLHours) * time.Hour)
+}
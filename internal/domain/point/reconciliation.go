@@ -0,0 +1,62 @@
+package point
+
+import "time"
+
+// LedgerSummary 거래 내역으로 재계산한 사용자 포인트
+type LedgerSummary struct {
+	UserID         int64
+	NetBalance     int64 // 사용 가능 포인트 - 부채
+	PendingBalance int64 // 적립 예정 포인트
+	HeldBalance    int64 // 예약 포인트
+	TotalEarned    int64 // 누적 적립
+	TotalUsed      int64 // 누적 사용
+}
+
+// Reconciliation 사용자 잔액과 거래 내역의 정합성 검증 결과
+type Reconciliation struct {
+	ID                     int64
+	UserID                 int64
+	ExpectedNetBalance     int64
+	ActualNetBalance       int64
+	ExpectedPendingBalance int64
+	ActualPendingBalance   int64
+	ExpectedHeldBalance    int64
+	ActualHeldBalance      int64
+	ExpectedTotalEarned    int64
+	ActualTotalEarned      int64
+	ExpectedTotalUsed      int64
+	ActualTotalUsed        int64
+	Corrected              bool // 보정 거래로 자동 보정 여부
+	CreatedAt              time.Time
+}
+
+// NewReconciliation 사용자 잔액과 거래 내역 재계산 결과 비교
+func NewReconciliation(userPoint *UserPoint, ledger *LedgerSummary) *Reconciliation {
+	return &Reconciliation{
+		UserID:                 userPoint.UserID,
+		ExpectedNetBalance:     ledger.NetBalance,
+		ActualNetBalance:       userPoint.NetBalance(),
+		ExpectedPendingBalance: ledger.PendingBalance,
+		ActualPendingBalance:   userPoint.PendingBalance,
+		ExpectedHeldBalance:    ledger.HeldBalance,
+		ActualHeldBalance:      userPoint.HeldBalance,
+		ExpectedTotalEarned:    ledger.TotalEarned,
+		ActualTotalEarned:      userPoint.TotalEarned,
+		ExpectedTotalUsed:      ledger.TotalUsed,
+		ActualTotalUsed:        userPoint.TotalUsed,
+	}
+}
+
+// HasDiscrepancy 불일치 여부
+func (r *Reconciliation) HasDiscrepancy() bool {
+	return r.ExpectedNetBalance != r.ActualNetBalance ||
+		r.ExpectedPendingBalance != r.ActualPendingBalance ||
+		r.ExpectedHeldBalance != r.ActualHeldBalance ||
+		r.ExpectedTotalEarned != r.ActualTotalEarned ||
+		r.ExpectedTotalUsed != r.ActualTotalUsed
+}
+
+// BalanceGap 잔액 테이블 순잔액 - 거래 내역 기준 순잔액 (양수면 거래 내역이 부족)
+func (r *Reconciliation) BalanceGap() int64 {
+	return r.ActualNetBalance - r.ExpectedNetBalance
+}
@@ -0,0 +1,47 @@
+package point
+
+import "time"
+
+// OrderRefund 주문별 부분 환불 누적 내역
+// 최초 부분 환불 시점의 원 결제 금액/사용 포인트/적립 포인트를 기준으로 환불 한도를 관리
+type OrderRefund struct {
+	ID                    int64
+	OrderID               int64
+	UserID                int64
+	PaymentAmount         int64 // 원 결제 금액
+	UsedPointAmount       int64 // 원 사용 포인트
+	EarnedPointAmount     int64 // 원 구매 적립 포인트
+	RefundedPaymentAmount int64 // 누적 환불 결제 금액
+	RestoredPointAmount   int64 // 누적 복구 사용 포인트
+	ClawedBackPointAmount int64 // 누적 회수 적립 포인트
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+}
+
+// RemainingPaymentAmount 환불 가능한 남은 결제 금액
+func (r *OrderRefund) RemainingPaymentAmount() int64 {
+	return r.PaymentAmount - r.RefundedPaymentAmount
+}
+
+// RestorablePointAmount 복구 가능한 남은 사용 포인트
+func (r *OrderRefund) RestorablePointAmount() int64 {
+	return r.UsedPointAmount - r.RestoredPointAmount
+}
+
+// RetainedEarnedPointAmount 회수되지 않고 남아 있는 적립 포인트
+func (r *OrderRefund) RetainedEarnedPointAmount() int64 {
+	return r.EarnedPointAmount - r.ClawedBackPointAmount
+}
+
+// IsFullyRefunded 결제 금액 전액 환불 여부
+func (r *OrderRefund) IsFullyRefunded() bool {
+	return r.RefundedPaymentAmount >= r.PaymentAmount
+}
+
+// Apply 부분 환불 누적
+func (r *OrderRefund) Apply(refundPaymentAmount, restoredPoints, clawedBackPoints int64) {
+	r.RefundedPaymentAmount += refundPaymentAmount
+	r.RestoredPointAmount += restoredPoints
+	r.ClawedBackPointAmount += clawedBackPoints
+	r.UpdatedAt = time.Now()
+}
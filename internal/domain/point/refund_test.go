@@ -0,0 +1,127 @@
+package point
+
+import "testing"
+
+func TestCalculateRefundPoints(t *testing.T) {
+	policy := NewDefaultPolicy()
+
+	tests := []struct {
+		name          string
+		usedPoints    int64
+		paymentAmount int64
+		refundPayment int64
+		want          int64
+	}{
+		{"half refund", 2000, 10000, 5000, 1000},
+		{"rounds down", 1000, 30000, 10000, 333},
+		{"full refund", 2000, 10000, 10000, 2000},
+		{"over refund capped", 2000, 10000, 20000, 2000},
+		{"zero refund", 2000, 10000, 0, 0},
+		{"unknown payment", 2000, 0, 5000, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.CalculateRefundPoints(tt.usedPoints, tt.paymentAmount, tt.refundPayment); got != tt.want {
+				t.Errorf("CalculateRefundPoints() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalculateEarnClawback(t *testing.T) {
+	policy := NewDefaultPolicy()
+
+	tests := []struct {
+		name             string
+		earned           int64
+		retained         int64
+		paymentAmount    int64
+		remainingPayment int64
+		want             int64
+	}{
+		{"first partial refund", 500, 500, 10000, 6000, 200},
+		{"second partial refund", 500, 300, 10000, 2000, 200},
+		{"last refund claws back rest", 500, 100, 10000, 0, 100},
+		{"nothing refunded", 500, 500, 10000, 10000, 0},
+		{"already clawed back more", 500, 100, 10000, 6000, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.CalculateEarnClawback(tt.earned, tt.retained, tt.paymentAmount, tt.remainingPayment); got != tt.want {
+				t.Errorf("CalculateEarnClawback() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderRefundAccumulation(t *testing.T) {
+	policy := NewDefaultPolicy()
+	refund := &OrderRefund{PaymentAmount: 10000, UsedPointAmount: 2000, EarnedPointAmount: 500}
+
+	steps := []struct {
+		refundPayment int64
+		wantRestored  int64
+		wantClawback  int64
+		wantFully     bool
+	}{
+		{3000, 600, 150, false},
+		{3000, 600, 150, false},
+		{4000, 800, 200, true},
+	}
+
+	for i, step := range steps {
+		restored := policy.CalculateRefundPoints(refund.UsedPointAmount, refund.PaymentAmount, step.refundPayment)
+		if restored > refund.RestorablePointAmount() {
+			restored = refund.RestorablePointAmount()
+		}
+		remaining := refund.RemainingPaymentAmount() - step.refundPayment
+		clawback := policy.CalculateEarnClawback(refund.EarnedPointAmount, refund.RetainedEarnedPointAmount(), refund.PaymentAmount, remaining)
+		refund.Apply(step.refundPayment, restored, clawback)
+
+		if restored != step.wantRestored || clawback != step.wantClawback {
+			t.Errorf("step %d: restored %d clawback %d, want %d %d", i, restored, clawback, step.wantRestored, step.wantClawback)
+		}
+		if refund.IsFullyRefunded() != step.wantFully {
+			t.Errorf("step %d: IsFullyRefunded() = %v, want %v", i, refund.IsFullyRefunded(), step.wantFully)
+		}
+	}
+
+	if refund.RestoredPointAmount != refund.UsedPointAmount || refund.RetainedEarnedPointAmount() != 0 {
+		t.Errorf("after full refund restored %d retained %d", refund.RestoredPointAmount, refund.RetainedEarnedPointAmount())
+	}
+}
+
+func TestOrderLineRefund(t *testing.T) {
+	tests := []struct {
+		name         string
+		refunds      []int64
+		wantClawback []int64
+		wantErr      error
+	}{
+		{"single unit", []int64{1}, []int64{100}, nil},
+		{"remaining quantity claws back rest", []int64{1, 2}, []int64{100, 200}, nil},
+		{"uneven split", []int64{2, 1}, []int64{200, 100}, nil},
+		{"over quantity", []int64{4}, nil, ErrRefundExceedsQuantity},
+		{"zero quantity", []int64{0}, nil, ErrRefundExceedsQuantity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			line := &OrderLine{LineItem: LineItem{Quantity: 3}, EarnedPoints: 300}
+			for i, quantity := range tt.refunds {
+				clawback, err := line.Refund(quantity)
+				if err != tt.wantErr {
+					t.Fatalf("Refund(%d) error = %v, want %v", quantity, err, tt.wantErr)
+				}
+				if err != nil {
+					return
+				}
+				if clawback != tt.wantClawback[i] {
+					t.Errorf("Refund(%d) clawback = %d, want %d", quantity, clawback, tt.wantClawback[i])
+				}
+			}
+		})
+	}
+}
@@ -7,7 +7,10 @@ import (
 
 // Repository 포인트 리포지토리 인터페이스
 type Repository interface {
-	// GetUserPoint 사용자 포인트 조회 (락 포함)
+	// GetUserPointForUpdate 사용자 포인트 조회 (FOR UPDATE 락 포함, 잔액을 변경하는 트랜잭션에서 사용)
+	GetUserPointForUpdate(ctx context.Context, userID int64) (*UserPoint, error)
+
+	// GetUserPoint 사용자 포인트 조회 (락 없음, 조회 전용)
 	GetUserPoint(ctx context.Context, userID int64) (*UserPoint, error)
 
 	// CreateUserPoint 사용자 포인트 생성
@@ -16,17 +19,35 @@ type Repository interface {
 	// UpdateUserPoint 사용자 포인트 업데이트
 	UpdateUserPoint(ctx context.Context, userPoint *UserPoint) error
 
+	// GetUsersWithDebt 포인트 부채가 있는 사용자 조회 (부채 큰 순)
+	GetUsersWithDebt(ctx context.Context, limit, offset int) ([]*UserPoint, error)
+
+	// GetDebtSummary 포인트 부채 집계 (부채 사용자 수, 총 부채)
+	GetDebtSummary(ctx context.Context) (userCount int64, totalDebt int64, err error)
+
 	// CreateTransaction 거래 내역 생성
 	CreateTransaction(ctx context.Context, tx *Transaction) error
 
-	// GetEarnedTransactions 적립 거래 내역 조회 (FIFO용, 만료일 순)
-	GetEarnedTransactions(ctx context.Context, userID int64, limit int) ([]*Transaction, error)
+	// GetEarnedTransactions 잔여 포인트가 있고 만료일이 지나지 않은 적립 거래 내역 조회 (지갑 순서, 지갑 내에서는 만료일 순, FOR UPDATE 락)
+	GetEarnedTransactions(ctx context.Context, userID int64, walletOrder []Wallet, limit, offset int) ([]*Transaction, error)
+
+	// GetWalletBalances 지갑별 사용 가능 포인트 조회 (만료되지 않은 확정 적립 lot 의 잔여 포인트 합계)
+	GetWalletBalances(ctx context.Context, userID int64) (map[Wallet]int64, error)
 
 	// UpdateTransaction 거래 내역 업데이트
 	UpdateTransaction(ctx context.Context, tx *Transaction) error
 
-	// GetExpiringTransactions 만료 예정 거래 내역 조회
-	GetExpiringTransactions(ctx context.Context, before time.Time, limit int) ([]*Transaction, error)
+	// GetExpiringUserIDs 만료 대상 적립 lot 이 있는 사용자 ID 조회 (가장 먼저 만료되는 사용자 순)
+	GetExpiringUserIDs(ctx context.Context, before time.Time, limit int) ([]int64, error)
+
+	// GetExpiringTransactionsForUpdate 사용자의 만료 대상 적립 lot 조회 (FOR UPDATE 락, 사용자 포인트 락 획득 후 호출)
+	GetExpiringTransactionsForUpdate(ctx context.Context, userID int64, before time.Time) ([]*Transaction, error)
+
+	// GetDuePendingUserIDs 적립 확정 예정일이 지난 적립 예정 거래가 있는 사용자 ID 조회 (가장 먼저 확정 예정인 사용자 순)
+	GetDuePendingUserIDs(ctx context.Context, before time.Time, limit int) ([]int64, error)
+
+	// GetDuePendingTransactionsForUpdate 사용자의 적립 확정 예정일이 지난 적립 예정 거래 내역 조회 (FOR UPDATE 락, 사용자 포인트 락 획득 후 호출)
+	GetDuePendingTransactionsForUpdate(ctx context.Context, userID int64, before time.Time) ([]*Transaction, error)
 
 	// GetTransactionsByUser 사용자 거래 내역 조회
 	GetTransactionsByUser(ctx context.Context, userID int64, limit, offset int) ([]*Transaction, error)
@@ -36,10 +57,164 @@ type Repository interface {
 
 	// GetTransactionsByOrderID 주문 ID로 거래 내역 조회
 	GetTransactionsByOrderID(ctx context.Context, orderID int64) ([]*Transaction, error)
+
+	// CreateAllocation 적립 lot 차감 내역 생성
+	CreateAllocation(ctx context.Context, allocation *Allocation) error
+
+	// UpdateAllocation 적립 lot 차감 내역 업데이트
+	UpdateAllocation(ctx context.Context, allocation *Allocation) error
+
+	// GetAllocationsByUseTransactionID 사용 거래의 적립 lot 차감 내역 조회
+	GetAllocationsByUseTransactionID(ctx context.Context, useTransactionID int64) ([]*Allocation, error)
+
+	// CreateReservation 포인트 예약 생성
+	CreateReservation(ctx context.Context, reservation *Reservation) error
+
+	// UpdateReservation 포인트 예약 업데이트
+	UpdateReservation(ctx context.Context, reservation *Reservation) error
+
+	// GetHeldReservationByOrderID 주문의 진행 중인 포인트 예약 조회 (락 포함)
+	GetHeldReservationByOrderID(ctx context.Context, orderID int64) (*Reservation, error)
+
+	// GetOrderRefund 주문 부분 환불 내역 조회 (락 포함)
+	GetOrderRefund(ctx context.Context, orderID int64) (*OrderRefund, error)
+
+	// CreateOrderRefund 주문 부분 환불 내역 생성
+	CreateOrderRefund(ctx context.Context, refund *OrderRefund) error
+
+	// UpdateOrderRefund 주문 부분 환불 내역 업데이트
+	UpdateOrderRefund(ctx context.Context, refund *OrderRefund) error
+
+	// GetExpiredReservations 유효시간이 지난 진행 중 예약 조회
+	GetExpiredReservations(ctx context.Context, before time.Time, limit int) ([]*Reservation, error)
+
+	// GetUserIDsAfter afterUserID 다음 사용자 ID 목록 조회 (user_id 오름차순, 배치 처리용)
+	GetUserIDsAfter(ctx context.Context, afterUserID int64, limit int) ([]int64, error)
+
+	// GetLedgerSummary 사용자 거래 내역으로 잔액 재계산
+	GetLedgerSummary(ctx context.Context, userID int64) (*LedgerSummary, error)
+
+	// CreateReconciliation 정합성 검증 불일치 리포트 생성
+	CreateReconciliation(ctx context.Context, reconciliation *Reconciliation) error
+
+	// CreateAdjustmentApproval 관리자 조정 승인 요청 생성
+	CreateAdjustmentApproval(ctx context.Context, approval *AdjustmentApproval) error
+
+	// UpdateAdjustmentApproval 관리자 조정 승인 요청 업데이트
+	UpdateAdjustmentApproval(ctx context.Context, approval *AdjustmentApproval) error
+
+	// GetAdjustmentApprovalForUpdate 관리자 조정 승인 요청 조회 (락 포함)
+	GetAdjustmentApprovalForUpdate(ctx context.Context, id int64) (*AdjustmentApproval, error)
+
+	// GetAdjustmentApprovals 관리자 조정 승인 요청 목록 조회 (status 가 비어 있으면 전체, 최신순)
+	GetAdjustmentApprovals(ctx context.Context, status ApprovalStatus, limit, offset int) ([]*AdjustmentApproval, error)
+
+	// GetUserTier 사용자 회원 등급 조회 (등급이 없으면 기본 등급)
+	GetUserTier(ctx context.Context, userID int64) (*UserTier, error)
+
+	// GetUserTierForUpdate 사용자 회원 등급 조회 (FOR UPDATE 락 포함, 등급이 없으면 기본 등급)
+	GetUserTierForUpdate(ctx context.Context, userID int64) (*UserTier, error)
+
+	// SaveUserTier 사용자 회원 등급 저장 (없으면 생성)
+	SaveUserTier(ctx context.Context, userTier *UserTier) error
+
+	// CreateTierHistory 회원 등급 변경 이력 생성
+	CreateTierHistory(ctx context.Context, history *TierHistory) error
+
+	// HasTierBonus 해당 등급 승급 보너스를 받은 적이 있는지 확인
+	HasTierBonus(ctx context.Context, userID int64, tier Tier) (bool, error)
+
+	// GetPurchaseSpend since 이후 구매 확정된 주문의 결제 금액 합계 (부분 환불 금액 제외)
+	GetPurchaseSpend(ctx context.Context, userID int64, since time.Time) (int64, error)
+
+	// CreateCampaign 프로모션 캠페인 생성
+	CreateCampaign(ctx context.Context, campaign *Campaign) error
+
+	// UpdateCampaignSpent 캠페인 지급 예산 누계 업데이트
+	UpdateCampaignSpent(ctx context.Context, campaign *Campaign) error
+
+	// GetCampaign 캠페인 조회 (락 없음, 조회 전용)
+	GetCampaign(ctx context.Context, id int64) (*Campaign, error)
+
+	// GetCampaignForUpdate 캠페인 조회 (락 포함, 예산 차감 시 사용)
+	GetCampaignForUpdate(ctx context.Context, id int64) (*Campaign, error)
+
+	// GetActiveCampaigns at 시점에 진행 중인 캠페인 조회
+	GetActiveCampaigns(ctx context.Context, at time.Time) ([]*Campaign, error)
+
+	// GetCampaigns 캠페인 목록 조회 (최신순)
+	GetCampaigns(ctx context.Context, limit, offset int) ([]*Campaign, error)
+
+	// GetCampaignUserBonus 사용자가 캠페인으로 받은 추가 적립 합계 (취소 제외)
+	GetCampaignUserBonus(ctx context.Context, campaignID, userID int64) (int64, error)
+
+	// GetCampaignCost 캠페인 추가 적립 비용 집계
+	GetCampaignCost(ctx context.Context, campaignID int64) (*CampaignCost, error)
+
+	// GetEarnRules 카테고리/상품별 적립 규칙 조회
+	GetEarnRules(ctx context.Context) ([]*EarnRule, error)
+
+	// CreateOrderLine 주문 라인 적립 내역 생성
+	CreateOrderLine(ctx context.Context, line *OrderLine) error
+
+	// UpdateOrderLine 주문 라인 환불 수량/회수 포인트 업데이트
+	UpdateOrderLine(ctx context.Context, line *OrderLine) error
+
+	// GetOrderLines 주문 라인 적립 내역 조회 (라인 번호 순)
+	GetOrderLines(ctx context.Context, orderID int64) ([]*OrderLine, error)
+
+	// GetTierPolicy 회원 등급별 정책 재정의 조회 (없으면 재정의 없는 정책)
+	GetTierPolicy(ctx context.Context, tier Tier) (*TierPolicy, error)
+
+	// GetStaleAdjustmentApprovals 승인 대기 시간이 지난 승인 요청 조회
+	GetStaleAdjustmentApprovals(ctx context.Context, before time.Time, limit int) ([]*AdjustmentApproval, error)
+
+	// CreateOutboxEvent 도메인 이벤트를 outbox 에 저장
+	CreateOutboxEvent(ctx context.Context, event *OutboxEvent) error
+
+	// UpdateOutboxEvent outbox 이벤트 발행 상태 업데이트
+	UpdateOutboxEvent(ctx context.Context, event *OutboxEvent) error
+
+	// GetDispatchableOutboxEvents now 시점에 발행할 이벤트 조회 (ID 순)
+	// 같은 사용자의 앞선 이벤트가 재시도 대기 중이면 뒤 이벤트는 제외
+	GetDispatchableOutboxEvents(ctx context.Context, now time.Time, limit int) ([]*OutboxEvent, error)
+
+	// CreateWebhookSubscription 웹훅 구독 생성
+	CreateWebhookSubscription(ctx context.Context, subscription *WebhookSubscription) error
+
+	// UpdateWebhookSubscription 웹훅 구독 업데이트
+	UpdateWebhookSubscription(ctx context.Context, subscription *WebhookSubscription) error
+
+	// GetWebhookSubscription 웹훅 구독 조회
+	GetWebhookSubscription(ctx context.Context, id int64) (*WebhookSubscription, error)
+
+	// GetWebhookSubscriptions 웹훅 구독 목록 조회 (최신순)
+	GetWebhookSubscriptions(ctx context.Context, limit, offset int) ([]*WebhookSubscription, error)
+
+	// GetActiveWebhookSubscriptions 활성 웹훅 구독 전체 조회
+	GetActiveWebhookSubscriptions(ctx context.Context) ([]*WebhookSubscription, error)
+
+	// CreateWebhookDelivery 웹훅 전송 내역 생성 (같은 구독/이벤트가 이미 있으면 무시)
+	CreateWebhookDelivery(ctx context.Context, delivery *WebhookDelivery) error
+
+	// UpdateWebhookDelivery 웹훅 전송 상태 업데이트
+	UpdateWebhookDelivery(ctx context.Context, delivery *WebhookDelivery) error
+
+	// GetWebhookDelivery 웹훅 전송 내역 조회
+	GetWebhookDelivery(ctx context.Context, id int64) (*WebhookDelivery, error)
+
+	// GetWebhookDeliveries 구독의 웹훅 전송 내역 조회 (status 가 비어 있으면 전체, 최신순)
+	GetWebhookDeliveries(ctx context.Context, subscriptionID int64, status WebhookDeliveryStatus, limit, offset int) ([]*WebhookDelivery, error)
+
+	// GetDueWebhookDeliveries now 시점에 전송할 웹훅 전송 내역 조회
+	GetDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]*WebhookDelivery, error)
 }
 
 // TransactionManager 트랜잭션 관리자 인터페이스
 type TransactionManager interface {
 	// WithTransaction 트랜잭션 내에서 함수 실행
 	WithTransaction(ctx context.Context, fn func(context.Context) error) error
+
+	// AfterCommit 트랜잭션 커밋 후 실행할 함수 등록 (트랜잭션 밖이면 즉시 실행)
+	AfterCommit(ctx context.Context, fn func(context.Context))
 }
@@ -0,0 +1,37 @@
+package point
+
+import "time"
+
+// ReservationStatus 포인트 예약 상태
+type ReservationStatus string
+
+const (
+	ReservationStatusHeld     ReservationStatus = "HELD"     // 예약 중
+	ReservationStatusCaptured ReservationStatus = "CAPTURED" // 결제 완료로 사용 확정
+	ReservationStatusReleased ReservationStatus = "RELEASED" // 결제 실패로 해제
+	ReservationStatusExpired  ReservationStatus = "EXPIRED"  // 유효시간 초과로 자동 해제
+)
+
+// Reservation 결제 전 포인트 예약 (hold → capture / release)
+type Reservation struct {
+	ID               int64
+	UserID           int64
+	OrderID          int64
+	UseTransactionID int64 // 예약으로 생성된 사용 거래 (PENDING)
+	Amount           int64
+	OrderAmount      int64
+	Status           ReservationStatus
+	ExpiresAt        time.Time
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// IsHeld 예약 중인지 확인
+func (r *Reservation) IsHeld() bool {
+	return r.Status == ReservationStatusHeld
+}
+
+// IsExpired 예약 유효시간이 지났는지 확인
+func (r *Reservation) IsExpired(now time.Time) bool {
+	return now.After(r.ExpiresAt)
+}
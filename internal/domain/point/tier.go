@@ -0,0 +1,111 @@
+package point
+
+import "time"
+
+// Tier 회원 등급
+type Tier string
+
+const (
+	TierBronze Tier = "BRONZE"
+	TierSilver Tier = "SILVER"
+	TierGold   Tier = "GOLD"
+	TierVIP    Tier = "VIP"
+)
+
+// DefaultTier 등급이 지정되지 않은 회원의 기본 등급
+const DefaultTier = TierBronze
+
+// IsValid 정의된 회원 등급인지 확인
+func (t Tier) IsValid() bool {
+	switch t {
+	case TierBronze, TierSilver, TierGold, TierVIP:
+		return true
+	}
+	return false
+}
+
+// Rank 등급 순위 (높을수록 상위 등급)
+func (t Tier) Rank() int {
+	switch t {
+	case TierSilver:
+		return 1
+	case TierGold:
+		return 2
+	case TierVIP:
+		return 3
+	}
+	return 0
+}
+
+// UserTier 사용자 회원 등급
+type UserTier struct {
+	UserID      int64
+	Tier        Tier
+	SpendAmount int64      // 마지막 등급 산정 시 구매 실적
+	DowngradeAt *time.Time // 유예 기간 종료 시각 (하향 대상일 때만 설정, 이후에도 실적이 낮으면 하향)
+	EvaluatedAt *time.Time // 마지막 등급 산정 시각
+	UpdatedAt   time.Time
+}
+
+// Evaluate 구매 실적으로 산정한 등급 반영 (등급이 바뀌면 변경 이력 반환)
+// 상향은 즉시 반영하고, 하향은 유예 기간이 지난 뒤에도 실적이 낮을 때만 반영
+func (u *UserTier) Evaluate(target Tier, spendAmount int64, now time.Time, graceDays int) *TierHistory {
+	u.SpendAmount = spendAmount
+	u.EvaluatedAt = &now
+
+	if target.Rank() >= u.Tier.Rank() {
+		u.DowngradeAt = nil
+		if target == u.Tier {
+			return nil
+		}
+		return u.changeTo(target, now)
+	}
+
+	// 하향 대상: 유예 기간 시작 또는 종료 확인
+	if u.DowngradeAt == nil {
+		downgradeAt := now.AddDate(0, 0, graceDays)
+		u.DowngradeAt = &downgradeAt
+	}
+	if now.Before(*u.DowngradeAt) {
+		return nil
+	}
+	u.DowngradeAt = nil
+	return u.changeTo(target, now)
+}
+
+// changeTo 등급 변경 후 변경 이력 생성
+func (u *UserTier) changeTo(target Tier, now time.Time) *TierHistory {
+	history := &TierHistory{
+		UserID:      u.UserID,
+		FromTier:    u.Tier,
+		ToTier:      target,
+		SpendAmount: u.SpendAmount,
+		CreatedAt:   now,
+	}
+	u.Tier = target
+	return history
+}
+
+// TierHistory 회원 등급 변경 이력
+type TierHistory struct {
+	ID                 int64
+	UserID             int64
+	FromTier           Tier
+	ToTier             Tier
+	SpendAmount        int64  // 등급 산정 기준 구매 실적
+	BonusTransactionID *int64 // 승급 보너스 거래 ID
+	CreatedAt          time.Time
+}
+
+// IsUpgrade 상향 변경인지 확인
+func (h *TierHistory) IsUpgrade() bool {
+	return h.ToTier.Rank() > h.FromTier.Rank()
+}
+
+// TierPolicy 회원 등급별 정책 재정의 (nil 인 항목은 기본 정책 사용)
+type TierPolicy struct {
+	Tier            Tier
+	EarnRate        *float64 // 적립률
+	MaxEarnPerOrder *int64   // 주문당 최대 적립
+	MaxUseRate      *float64 // 최대 사용 비율
+}
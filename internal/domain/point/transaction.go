@@ -21,6 +21,7 @@ const (
 	ReasonTypeSignup   ReasonType = "SIGNUP"   // 가입
 	ReasonTypeRefund   ReasonType = "REFUND"   // 환불
 	ReasonTypeAdmin    ReasonType = "ADMIN"    // 관리자
+	ReasonTypeGrade    ReasonType = "GRADE"    // 등급 승급 보너스
 )
 
 // TransactionStatus 거래 상태
@@ -34,19 +35,25 @@ const (
 
 // Transaction 포인트 거래 내역
 type Transaction struct {
-	ID           int64
-	UserID       int64
-	Type         TransactionType
-	Amount       int64
-	BalanceAfter int64
-	ReasonType   ReasonType
-	ReasonDetail string
-	OrderID      *int64
-	EarnedAt     *time.Time
-	ExpiresAt    *time.Time
-	Expired      bool
-	Status       TransactionStatus
-	CreatedAt    time.Time
+	ID              int64
+	UserID          int64
+	Type            TransactionType
+	Amount          int64
+	RemainingAmount int64 // 미사용 잔여 포인트 (EARN lot)
+	BalanceAfter    int64
+	ReasonType      ReasonType
+	ReasonDetail    string
+	OperatorID      string // 처리 운영자 ID (관리자 수동 조정)
+	TicketRef       string // 고객 문의 티켓 번호 (관리자 수동 조정)
+	CampaignID      *int64 // 프로모션 캠페인 추가 적립인 경우 캠페인 ID
+	Wallet          Wallet // 적립 지갑 (적립 lot/만료 거래, 여러 지갑에서 차감하는 거래는 비어 있음)
+	OrderID         *int64
+	EarnedAt        *time.Time
+	ScheduledAt     *time.Time // 적립 확정 예정일 (PENDING 적립)
+	ExpiresAt       *time.Time
+	Expired         bool
+	Status          TransactionStatus
+	CreatedAt       time.Time
 }
 
 // IsExpired 만료 여부 확인
@@ -56,3 +63,56 @@ func (t *Transaction) IsExpired() bool {
 	}
 	return t.Expired || time.Now().After(*t.ExpiresAt)
 }
+
+// IsPurchaseUse 주문 결제에 사용(예약 포함)된 거래 여부
+func (t *Transaction) IsPurchaseUse() bool {
+	return t.Type == TransactionTypeUse &&
+		t.ReasonType == ReasonTypePurchase &&
+		t.Status != TransactionStatusCancelled
+}
+
+// IsPurchaseEarn 구매 적립 거래 여부 (적립 예정 포함, 취소 제외)
+func (t *Transaction) IsPurchaseEarn() bool {
+	return t.Type == TransactionTypeEarn &&
+		t.ReasonType == ReasonTypePurchase &&
+		t.Status != TransactionStatusCancelled
+}
+
+// IsRefund 환불 처리로 생성된 거래 여부
+func (t *Transaction) IsRefund() bool {
+	return t.ReasonType == ReasonTypeRefund
+}
+
+// IsPendingEarn 적립 예정 거래 여부
+func (t *Transaction) IsPendingEarn() bool {
+	return t.Type == TransactionTypeEarn && t.Status == TransactionStatusPending
+}
+
+// ConfirmEarn 적립 예정 거래를 확정된 적립 lot 으로 전환 (expiresAt 이 nil 이면 만료 없음)
+func (t *Transaction) ConfirmEarn(earnedAt time.Time, expiresAt *time.Time) {
+	t.Status = TransactionStatusConfirmed
+	t.RemainingAmount = t.Amount
+	t.EarnedAt = &earnedAt
+	t.ExpiresAt = expiresAt
+}
+
+// Consume 적립 lot 에서 최대 amount 만큼 차감하고 실제 차감 금액 반환
+func (t *Transaction) Consume(amount int64) int64 {
+	if amount > t.RemainingAmount {
+		amount = t.RemainingAmount
+	}
+	t.RemainingAmount -= amount
+	return amount
+}
+
+// Restore 환불된 포인트를 적립 lot 에 복구
+func (t *Transaction) Restore(amount int64) {
+	t.RemainingAmount += amount
+}
+
+// CanRestore 환불 포인트를 복구할 수 있는 lot 인지 확인
+func (t *Transaction) CanRestore() bool {
+	return t.Type == TransactionTypeEarn &&
+		t.Status == TransactionStatusConfirmed &&
+		!t.IsExpired()
+}
@@ -0,0 +1,86 @@
+package point
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Wallet 포인트 지갑 (유효기간과 사용 우선순위가 다른 포인트 구분)
+type Wallet string
+
+const (
+	WalletRegular Wallet = "REGULAR" // 일반 포인트 (기본 유효기간)
+	WalletEvent   Wallet = "EVENT"   // 이벤트 포인트 (짧은 유효기간)
+	WalletCash    Wallet = "CASH"    // 현금성 포인트 (만료 없음)
+)
+
+// DefaultWallet 지갑을 지정하지 않은 적립의 지갑
+const DefaultWallet = WalletRegular
+
+// Wallets 전체 지갑 목록 (기본 사용 우선순위 순)
+var Wallets = []Wallet{WalletEvent, WalletRegular, WalletCash}
+
+// IsValid 유효한 지갑인지 확인
+func (w Wallet) IsValid() bool {
+	switch w {
+	case WalletRegular, WalletEvent, WalletCash:
+		return true
+	}
+	return false
+}
+
+// OrDefault 비어 있으면 기본 지갑 반환
+func (w Wallet) OrDefault() Wallet {
+	if w == "" {
+		return DefaultWallet
+	}
+	return w
+}
+
+// ParseWalletOrder 쉼표로 구분한 지갑 사용 우선순위 파싱
+// 목록에 없는 지갑은 기본 우선순위대로 뒤에 추가
+func ParseWalletOrder(value string) ([]Wallet, error) {
+	seen := make(map[Wallet]bool, len(Wallets))
+	var order []Wallet
+	for _, name := range strings.Split(value, ",") {
+		wallet := Wallet(strings.ToUpper(strings.TrimSpace(name)))
+		if wallet == "" {
+			continue
+		}
+		if !wallet.IsValid() {
+			return nil, fmt.Errorf("unknown wallet: %s", name)
+		}
+		if seen[wallet] {
+			continue
+		}
+		seen[wallet] = true
+		order = append(order, wallet)
+	}
+
+	for _, wallet := range Wallets {
+		if !seen[wallet] {
+			order = append(order, wallet)
+		}
+	}
+	return order, nil
+}
+
+// WalletRule 지갑별 유효기간 규칙
+type WalletRule struct {
+	ExpiryMonths int  // 유효기간 (월)
+	ExpiryDays   int  // 유효기간 (일, ExpiryMonths 가 0 일 때 사용)
+	NeverExpires bool // 만료 없음
+}
+
+// ExpiryDate 적립일 기준 만료일 (만료 없으면 nil)
+func (r WalletRule) ExpiryDate(earnedAt time.Time) *time.Time {
+	if r.NeverExpires {
+		return nil
+	}
+	expiresAt := earnedAt.AddDate(0, r.ExpiryMonths, 0)
+	if r.ExpiryMonths == 0 {
+		expiresAt = earnedAt.AddDate(0, 0, r.ExpiryDays)
+	}
+	return &expiresAt
+}
@@ -0,0 +1,168 @@
+package point
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// minWebhookSecretLength 웹훅 서명 비밀키 최소 길이
+const minWebhookSecretLength = 16
+
+// WebhookSubscription 포인트 이벤트 웹훅 구독
+type WebhookSubscription struct {
+	ID          int64
+	URL         string
+	EventTypes  []EventType // 구독 이벤트 유형 (비어 있으면 전체)
+	Secret      string      // 서명 비밀키 (HMAC-SHA256)
+	Description string
+	Active      bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Validate 웹훅 구독 설정 검증
+func (s *WebhookSubscription) Validate() error {
+	parsed, err := url.Parse(s.URL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return ErrInvalidWebhook
+	}
+	if len(s.Secret) < minWebhookSecretLength {
+		return ErrInvalidWebhook
+	}
+	for _, eventType := range s.EventTypes {
+		if !eventType.IsValid() {
+			return ErrInvalidWebhook
+		}
+	}
+	return nil
+}
+
+// Matches 이벤트 유형을 구독하는지 확인
+func (s *WebhookSubscription) Matches(eventType EventType) bool {
+	if len(s.EventTypes) == 0 {
+		return true
+	}
+	for _, subscribed := range s.EventTypes {
+		if subscribed == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// NewWebhookSecret 무작위 웹훅 서명 비밀키 생성
+func NewWebhookSecret() string {
+	var b [32]byte
+	_, _ = rand.Read(b[:])
+	return "whsec_" + hex.EncodeToString(b[:])
+}
+
+// WebhookDeliveryStatus 웹훅 전송 상태
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "PENDING"   // 전송 대기 (재시도 포함)
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "DELIVERED" // 전송 완료 (2xx 응답)
+	WebhookDeliveryDead      WebhookDeliveryStatus = "DEAD"      // 최대 시도 횟수 초과 또는 구독 비활성 (dead letter)
+)
+
+// WebhookDelivery 구독별 이벤트 웹훅 전송 내역
+type WebhookDelivery struct {
+	ID             int64
+	SubscriptionID int64
+	EventID        string
+	EventType      EventType
+	Payload        []byte // 이벤트 페이로드 (재전송 시 그대로 사용)
+	Status         WebhookDeliveryStatus
+	Attempts       int       // 전송 시도 횟수
+	NextAttemptAt  time.Time // 다음 전송 시도 시각
+	LastStatusCode int       // 마지막 응답 상태 코드 (응답이 없으면 0)
+	LastError      string
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// NewWebhookDelivery 구독에 보낼 이벤트 전송 내역 생성
+func NewWebhookDelivery(subscription *WebhookSubscription, event *OutboxEvent, now time.Time) *WebhookDelivery {
+	return &WebhookDelivery{
+		SubscriptionID: subscription.ID,
+		EventID:        event.EventID,
+		EventType:      event.EventType,
+		Payload:        event.Payload,
+		Status:         WebhookDeliveryPending,
+		NextAttemptAt:  now,
+		CreatedAt:      now,
+	}
+}
+
+// MarkDelivered 전송 완료 처리
+func (d *WebhookDelivery) MarkDelivered(statusCode int, now time.Time) {
+	d.Attempts++
+	d.Status = WebhookDeliveryDelivered
+	d.LastStatusCode = statusCode
+	d.LastError = ""
+	d.DeliveredAt = &now
+}
+
+// MarkFailed 전송 실패 처리 (재시도 정책에 따라 다음 시도 시각 설정, 최대 시도 횟수를 넘으면 dead letter)
+func (d *WebhookDelivery) MarkFailed(statusCode int, cause error, now time.Time, policy DispatchPolicy) {
+	d.Attempts++
+	d.LastStatusCode = statusCode
+	d.LastError = cause.Error()
+	if policy.MaxAttempts > 0 && d.Attempts >= policy.MaxAttempts {
+		d.Status = WebhookDeliveryDead
+		return
+	}
+	d.NextAttemptAt = now.Add(policy.Backoff(d.Attempts))
+}
+
+// MarkDead 재시도 없이 dead letter 처리 (구독 삭제/비활성 등)
+func (d *WebhookDelivery) MarkDead(reason string) {
+	d.Status = WebhookDeliveryDead
+	d.LastError = reason
+}
+
+// Redeliver 관리자 재전송 요청 (시도 횟수를 초기화하고 즉시 전송 대기)
+func (d *WebhookDelivery) Redeliver(now time.Time) {
+	d.Status = WebhookDeliveryPending
+	d.Attempts = 0
+	d.NextAttemptAt = now
+	d.DeliveredAt = nil
+}
+
+// WebhookSender 웹훅 전송 인터페이스
+type WebhookSender interface {
+	// Send 서명한 페이로드를 구독 URL 로 전송하고 응답 상태 코드 반환 (2xx 가 아니면 오류)
+	Send(ctx context.Context, subscription *WebhookSubscription, delivery *WebhookDelivery) (int, error)
+}
+
+// SignWebhookPayload 웹훅 서명 생성
+// "{timestamp}.{payload}" 를 비밀키로 HMAC-SHA256 한 값 (hex)
+func SignWebhookPayload(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhookSignature 웹훅 서명 검증 (수신 측 검증용)
+// 서명 시각이 now 기준 tolerance 를 벗어나면 재전송 공격으로 보고 거부
+func VerifyWebhookSignature(secret string, timestamp int64, payload []byte, signature string, now time.Time, tolerance time.Duration) error {
+	signedAt := time.Unix(timestamp, 0)
+	if signedAt.Before(now.Add(-tolerance)) || signedAt.After(now.Add(tolerance)) {
+		return ErrWebhookSignatureExpired
+	}
+	expected := SignWebhookPayload(secret, timestamp, payload)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return ErrInvalidWebhookSignature
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package point
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	payload := []byte(`{"event_id":"e-1"}`)
+	secret := "whsec_0123456789abcdef"
+	signature := SignWebhookPayload(secret, now.Unix(), payload)
+
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp int64
+		payload   []byte
+		signature string
+		wantErr   error
+	}{
+		{"valid", secret, now.Unix(), payload, signature, nil},
+		{"within tolerance", secret, now.Add(-4 * time.Minute).Unix(), payload, SignWebhookPayload(secret, now.Add(-4*time.Minute).Unix(), payload), nil},
+		{"wrong secret", "whsec_other_secret_value", now.Unix(), payload, signature, ErrInvalidWebhookSignature},
+		{"tampered payload", secret, now.Unix(), []byte(`{"event_id":"e-2"}`), signature, ErrInvalidWebhookSignature},
+		{"old timestamp", secret, now.Add(-10 * time.Minute).Unix(), payload, SignWebhookPayload(secret, now.Add(-10*time.Minute).Unix(), payload), ErrWebhookSignatureExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyWebhookSignature(tt.secret, tt.timestamp, tt.payload, tt.signature, now, 5*time.Minute)
+			if err != tt.wantErr {
+				t.Errorf("VerifyWebhookSignature() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWebhookDeliveryRetryAndDeadLetter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	policy := DispatchPolicy{MaxAttempts: 3, BaseBackoff: time.Second, MaxBackoff: time.Minute}
+	delivery := &WebhookDelivery{Status: WebhookDeliveryPending, NextAttemptAt: now}
+	cause := errors.New("webhook receiver responded with status 500")
+
+	delivery.MarkFailed(500, cause, now, policy)
+	if delivery.Status != WebhookDeliveryPending || !delivery.NextAttemptAt.Equal(now.Add(time.Second)) {
+		t.Fatalf("after 1st failure status %s next %v", delivery.Status, delivery.NextAttemptAt)
+	}
+	delivery.MarkFailed(500, cause, now, policy)
+	if delivery.Status != WebhookDeliveryPending || !delivery.NextAttemptAt.Equal(now.Add(2*time.Second)) {
+		t.Fatalf("after 2nd failure status %s next %v", delivery.Status, delivery.NextAttemptAt)
+	}
+	delivery.MarkFailed(500, cause, now, policy)
+	if delivery.Status != WebhookDeliveryDead || delivery.Attempts != 3 || delivery.LastStatusCode != 500 {
+		t.Fatalf("after max attempts status %s attempts %d code %d", delivery.Status, delivery.Attempts, delivery.LastStatusCode)
+	}
+
+	delivery.Redeliver(now.Add(time.Hour))
+	if delivery.Status != WebhookDeliveryPending || delivery.Attempts != 0 || !delivery.NextAttemptAt.Equal(now.Add(time.Hour)) {
+		t.Errorf("after redeliver status %s attempts %d next %v", delivery.Status, delivery.Attempts, delivery.NextAttemptAt)
+	}
+}
+
+func TestWebhookSubscriptionMatches(t *testing.T) {
+	tests := []struct {
+		name       string
+		eventTypes []EventType
+		eventType  EventType
+		want       bool
+	}{
+		{"all events", nil, EventTypeExpired, true},
+		{"subscribed", []EventType{EventTypeUsed, EventTypeExpired}, EventTypeExpired, true},
+		{"not subscribed", []EventType{EventTypeUsed}, EventTypeExpired, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &WebhookSubscription{EventTypes: tt.eventTypes}
+			if got := s.Matches(tt.eventType); got != tt.want {
+				t.Errorf("Matches(%s) = %v, want %v", tt.eventType, got, tt.want)
+			}
+		})
+	}
+}
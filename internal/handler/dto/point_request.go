@@ -1,5 +1,7 @@
 package dto
 
+import "time"
+
 // UsePointsRequest 포인트 사용 요청
 type UsePointsRequest struct {
 	OrderID     int64 `json:"order_id"`
@@ -9,8 +11,35 @@ type UsePointsRequest struct {
 
 // EarnPointsRequest 포인트 적립 요청
 type EarnPointsRequest struct {
-	OrderID       int64 `json:"order_id"`
-	PaymentAmount int64 `json:"payment_amount"`
+	OrderID       int64             `json:"order_id"`
+	PaymentAmount int64             `json:"payment_amount"`       // 주문 결제 금액 (포인트 사용분 포함, 현금 결제분 적립 시 사용 포인트를 빼고 계산)
+	Categories    []string          `json:"categories,omitempty"` // 주문 상품 카테고리 (카테고리 대상 캠페인 판단용)
+	Items         []LineItemRequest `json:"items,omitempty"`      // 주문 라인 (있으면 라인별 적립 계산)
+}
+
+// LineItemRequest 주문 라인
+type LineItemRequest struct {
+	LineNo        int    `json:"line_no"`
+	SKU           string `json:"sku"`
+	Category      string `json:"category"`
+	Quantity      int64  `json:"quantity"`
+	UnitPrice     int64  `json:"unit_price"`
+	Discount      int64  `json:"discount,omitempty"`
+	PointEligible *bool  `json:"point_eligible,omitempty"` // 적립 대상 여부 (없으면 true, 상품권 등은 false)
+}
+
+// QuoteRequest 결제 전 포인트 견적 요청
+type QuoteRequest struct {
+	OrderAmount int64             `json:"order_amount"`         // 포인트 사용 전 주문 금액
+	UseAmount   int64             `json:"use_amount,omitempty"` // 사용하려는 포인트 (없으면 0)
+	Categories  []string          `json:"categories,omitempty"` // 주문 상품 카테고리 (카테고리 대상 캠페인 판단용)
+	Items       []LineItemRequest `json:"items,omitempty"`      // 주문 라인 (있으면 라인별 적립 계산)
+}
+
+// RefundLineRequest 부분 환불 라인
+type RefundLineRequest struct {
+	LineNo   int   `json:"line_no"`
+	Quantity int64 `json:"quantity"`
 }
 
 // ReviewPointsRequest 리뷰 포인트 적립 요청
@@ -18,3 +47,72 @@ type ReviewPointsRequest struct {
 	IsPhoto bool `json:"is_photo"`
 }
 
+// ReservePointsRequest 포인트 예약 요청
+type ReservePointsRequest struct {
+	OrderID     int64 `json:"order_id"`
+	UseAmount   int64 `json:"use_amount"`
+	OrderAmount int64 `json:"order_amount"`
+}
+
+// PayOrderRequest 주문 결제 완료 요청
+type PayOrderRequest struct {
+	OrderAmount int64 `json:"order_amount"` // 주문 결제 금액 (포인트 사용분 포함)
+	PointUsed   int64 `json:"point_used"`   // 결제에 사용한 포인트 (0 이면 포인트 차감 없음)
+}
+
+// PartialRefundRequest 주문 부분 환불 요청
+type PartialRefundRequest struct {
+	RefundID            string              `json:"refund_id,omitempty"`           // 주문 서비스의 환불 ID (재시도 중복 방지 키)
+	PaymentAmount       int64               `json:"payment_amount"`                // 원 결제 금액
+	RefundPaymentAmount int64               `json:"refund_payment_amount"`         // 이번에 환불하는 결제 금액
+	RefundPointAmount   *int64              `json:"refund_point_amount,omitempty"` // 이번에 복구할 사용 포인트 (없으면 비율 계산)
+	Lines               []RefundLineRequest `json:"lines,omitempty"`               // 환불 라인 (있으면 해당 라인 적립 포인트를 회수)
+}
+
+// AdminAdjustPointsRequest 관리자 포인트 지급/차감 요청
+type AdminAdjustPointsRequest struct {
+	UserID        int64  `json:"user_id"`
+	Amount        int64  `json:"amount"`
+	Reason        string `json:"reason"`                    // 조정 사유 (필수)
+	TicketRef     string `json:"ticket_ref"`                // 고객 문의 티켓 번호 (필수)
+	ExpiresInDays int    `json:"expires_in_days,omitempty"` // 지급 포인트 유효기간 (없으면 지갑별 기본 유효기간, 지급에만 적용)
+	Wallet        string `json:"wallet,omitempty"`          // 지급 지갑 (REGULAR, EVENT, CASH, 없으면 REGULAR, 지급에만 적용)
+}
+
+// ReviewApprovalRequest 관리자 포인트 조정 승인/반려 요청
+type ReviewApprovalRequest struct {
+	Note string `json:"note,omitempty"` // 승인/반려 메모
+}
+
+// CreateCampaignRequest 프로모션 캠페인 생성 요청
+type CreateCampaignRequest struct {
+	Name             string    `json:"name"`
+	BonusType        string    `json:"bonus_type"`  // MULTIPLIER, RATE, FLAT
+	BonusValue       float64   `json:"bonus_value"` // 배수 / 추가 적립률 / 고정 포인트
+	StartsAt         time.Time `json:"starts_at"`
+	EndsAt           time.Time `json:"ends_at"`
+	Wallet           string    `json:"wallet,omitempty"` // 추가 적립 지갑 (없으면 REGULAR)
+	Tiers            []string  `json:"tiers,omitempty"`
+	ReasonTypes      []string  `json:"reason_types,omitempty"`
+	Categories       []string  `json:"categories,omitempty"`
+	MinPaymentAmount int64     `json:"min_payment_amount,omitempty"`
+	UserBudget       int64     `json:"user_budget,omitempty"`
+	TotalBudget      int64     `json:"total_budget,omitempty"`
+}
+
+// CreateWebhookRequest 웹훅 구독 생성 요청
+type CreateWebhookRequest struct {
+	URL         string   `json:"url"`
+	EventTypes  []string `json:"event_types,omitempty"` // 구독 이벤트 유형 (없으면 전체)
+	Secret      string   `json:"secret,omitempty"`      // 서명 비밀키 (16자 이상, 없으면 생성)
+	Description string   `json:"description,omitempty"`
+}
+
+// UpdateWebhookRequest 웹훅 구독 변경 요청 (없는 필드는 변경하지 않음)
+type UpdateWebhookRequest struct {
+	URL          *string   `json:"url,omitempty"`
+	EventTypes   *[]string `json:"event_types,omitempty"` // 빈 목록이면 전체 이벤트 구독
+	Description  *string   `json:"description,omitempty"`
+	Active       *bool     `json:"active,omitempty"`
+	RotateSecret bool      `json:"rotate_secret,omitempty"` // 서명 비밀키 재발급
+}
@@ -1,15 +1,67 @@
 package dto
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
+
+// QuoteResponse 결제 전 포인트 견적 응답
+type QuoteResponse struct {
+	UserID           int64  `json:"user_id"`
+	Tier             string `json:"tier"`
+	AvailableBalance int64  `json:"available_balance"`
+	OrderAmount      int64  `json:"order_amount"`
+	MaxUsableAmount  int64  `json:"max_usable_amount"`
+	UseLimit         string `json:"use_limit"` // 최대 사용 포인트 제한 사유 (BALANCE, MAX_USE_RATE, MIN_PAYMENT, MIN_USE_AMOUNT)
+
+	// 사용하려는 포인트 기준 견적
+	UseAmount      int64              `json:"use_amount"`
+	Usable         bool               `json:"usable"`
+	UseError       string             `json:"use_error,omitempty"` // 사용 정책 위반 사유
+	EarnableAmount int64              `json:"earnable_amount"`
+	EarnPoints     int64              `json:"earn_points"` // 캠페인 추가 적립 제외
+	Lines          []LineEarnResponse `json:"lines,omitempty"`
+
+	// 캠페인 추가 적립 예정 포인트 (조회 시점의 남은 예산 기준)
+	CampaignPoints  int64                   `json:"campaign_points"`
+	Campaigns       []CampaignBonusResponse `json:"campaigns,omitempty"`
+	TotalEarnPoints int64                   `json:"total_earn_points"` // 기본 적립 + 캠페인 추가 적립
+}
+
+// CampaignBonusResponse 캠페인별 추가 적립 예정 포인트
+type CampaignBonusResponse struct {
+	CampaignID int64  `json:"campaign_id"`
+	Name       string `json:"name"`
+	Points     int64  `json:"points"`
+}
+
+// LineEarnResponse 주문 라인별 적립 예정 포인트
+type LineEarnResponse struct {
+	LineNo     int     `json:"line_no"`
+	SKU        string  `json:"sku"`
+	EarnRate   float64 `json:"earn_rate"`
+	EarnPoints int64   `json:"earn_points"`
+}
 
 // BalanceResponse 잔액 응답
 type BalanceResponse struct {
 	UserID           int64     `json:"user_id"`
 	AvailableBalance int64     `json:"available_balance"`
 	PendingBalance   int64     `json:"pending_balance"`
+	HeldBalance      int64     `json:"held_balance"`
+	DebtBalance      int64     `json:"debt_balance"`
 	TotalEarned      int64     `json:"total_earned"`
 	TotalUsed        int64     `json:"total_used"`
 	UpdatedAt        time.Time `json:"updated_at"`
+
+	// 회원 등급 및 등급별 유효 정책
+	Tier            string  `json:"tier"`
+	EarnRate        float64 `json:"earn_rate"`
+	MaxEarnPerOrder int64   `json:"max_earn_per_order"`
+	MaxUseRate      float64 `json:"max_use_rate"`
+
+	// 지갑별 사용 가능 포인트 (REGULAR, EVENT, CASH)
+	WalletBalances map[string]int64 `json:"wallet_balances"`
 }
 
 // TransactionResponse 거래 내역 응답
@@ -21,6 +73,10 @@ type TransactionResponse struct {
 	BalanceAfter int64     `json:"balance_after"`
 	ReasonType   string    `json:"reason_type"`
 	ReasonDetail string    `json:"reason_detail"`
+	OperatorID   string    `json:"operator_id,omitempty"`
+	TicketRef    string    `json:"ticket_ref,omitempty"`
+	CampaignID   *int64    `json:"campaign_id,omitempty"`
+	Wallet       string    `json:"wallet,omitempty"`
 	OrderID      *int64    `json:"order_id,omitempty"`
 	EarnedAt     *string   `json:"earned_at,omitempty"`
 	ExpiresAt    *string   `json:"expires_at,omitempty"`
@@ -37,9 +93,187 @@ type TransactionsResponse struct {
 	Offset       int                   `json:"offset"`
 }
 
+// DebtUserResponse 부채 사용자 응답
+type DebtUserResponse struct {
+	UserID           int64     `json:"user_id"`
+	AvailableBalance int64     `json:"available_balance"`
+	DebtBalance      int64     `json:"debt_balance"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// DebtReportResponse 포인트 부채 현황 응답
+type DebtReportResponse struct {
+	UserCount int64              `json:"user_count"`
+	TotalDebt int64              `json:"total_debt"`
+	Users     []DebtUserResponse `json:"users"`
+	Limit     int                `json:"limit"`
+	Offset    int                `json:"offset"`
+}
+
+// ReconciliationResponse 잔액 정합성 검증 응답 (순잔액 = 사용 가능 포인트 - 부채)
+type ReconciliationResponse struct {
+	UserID                 int64 `json:"user_id"`
+	Consistent             bool  `json:"consistent"`
+	Corrected              bool  `json:"corrected"`
+	ExpectedNetBalance     int64 `json:"expected_net_balance"`
+	ActualNetBalance       int64 `json:"actual_net_balance"`
+	ExpectedPendingBalance int64 `json:"expected_pending_balance"`
+	ActualPendingBalance   int64 `json:"actual_pending_balance"`
+	ExpectedHeldBalance    int64 `json:"expected_held_balance"`
+	ActualHeldBalance      int64 `json:"actual_held_balance"`
+	ExpectedTotalEarned    int64 `json:"expected_total_earned"`
+	ActualTotalEarned      int64 `json:"actual_total_earned"`
+	ExpectedTotalUsed      int64 `json:"expected_total_used"`
+	ActualTotalUsed        int64 `json:"actual_total_used"`
+}
+
 // ErrorResponse 에러 응답
 type ErrorResponse struct {
 	Error   string `json:"error"`
 	Message string `json:"message,omitempty"`
 }
 
+// ReservationResponse 포인트 예약 응답
+type ReservationResponse struct {
+	ID          int64     `json:"id"`
+	UserID      int64     `json:"user_id"`
+	OrderID     int64     `json:"order_id"`
+	Amount      int64     `json:"amount"`
+	OrderAmount int64     `json:"order_amount"`
+	Status      string    `json:"status"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// OrderResponse 주문 응답
+type OrderResponse struct {
+	OrderID       int64      `json:"order_id"`
+	UserID        int64      `json:"user_id"`
+	Status        string     `json:"status"`
+	TotalAmount   int64      `json:"total_amount"`   // 주문 결제 금액 (포인트 사용분 포함)
+	PointUsed     int64      `json:"point_used"`     // 사용 포인트
+	PointToEarn   int64      `json:"point_to_earn"`  // 적립 예정 포인트
+	PaymentAmount int64      `json:"payment_amount"` // 실제 결제 금액
+	ConfirmedAt   *time.Time `json:"confirmed_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// PartialRefundResponse 주문 부분 환불 응답 (누적 기준)
+type PartialRefundResponse struct {
+	OrderID                int64 `json:"order_id"`
+	PaymentAmount          int64 `json:"payment_amount"`
+	RefundedPaymentAmount  int64 `json:"refunded_payment_amount"`
+	RemainingPaymentAmount int64 `json:"remaining_payment_amount"`
+	UsedPointAmount        int64 `json:"used_point_amount"`
+	RestoredPointAmount    int64 `json:"restored_point_amount"`
+	EarnedPointAmount      int64 `json:"earned_point_amount"`
+	ClawedBackPointAmount  int64 `json:"clawed_back_point_amount"`
+}
+
+// AdjustmentApprovalResponse 관리자 포인트 조정 승인 요청 응답
+type AdjustmentApprovalResponse struct {
+	ID            int64      `json:"id"`
+	Direction     string     `json:"direction"`
+	UserID        int64      `json:"user_id"`
+	Amount        int64      `json:"amount"`
+	Reason        string     `json:"reason"`
+	TicketRef     string     `json:"ticket_ref"`
+	ExpiresInDays int        `json:"expires_in_days,omitempty"`
+	Wallet        string     `json:"wallet,omitempty"`
+	RequestedBy   string     `json:"requested_by"`
+	ReviewedBy    string     `json:"reviewed_by,omitempty"`
+	ReviewNote    string     `json:"review_note,omitempty"`
+	TransactionID *int64     `json:"transaction_id,omitempty"`
+	Status        string     `json:"status"`
+	ExpiresAt     time.Time  `json:"expires_at"`
+	ReviewedAt    *time.Time `json:"reviewed_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// AdjustmentApprovalsResponse 관리자 포인트 조정 승인 요청 목록 응답
+type AdjustmentApprovalsResponse struct {
+	Approvals []AdjustmentApprovalResponse `json:"approvals"`
+	Total     int                          `json:"total"`
+	Limit     int                          `json:"limit"`
+	Offset    int                          `json:"offset"`
+}
+
+// CampaignResponse 프로모션 캠페인 응답
+type CampaignResponse struct {
+	ID               int64     `json:"id"`
+	Name             string    `json:"name"`
+	BonusType        string    `json:"bonus_type"`
+	BonusValue       float64   `json:"bonus_value"`
+	StartsAt         time.Time `json:"starts_at"`
+	EndsAt           time.Time `json:"ends_at"`
+	Wallet           string    `json:"wallet"`
+	Tiers            []string  `json:"tiers,omitempty"`
+	ReasonTypes      []string  `json:"reason_types,omitempty"`
+	Categories       []string  `json:"categories,omitempty"`
+	MinPaymentAmount int64     `json:"min_payment_amount"`
+	UserBudget       int64     `json:"user_budget"`
+	TotalBudget      int64     `json:"total_budget"`
+	SpentBudget      int64     `json:"spent_budget"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+// CampaignsResponse 프로모션 캠페인 목록 응답
+type CampaignsResponse struct {
+	Campaigns []CampaignResponse `json:"campaigns"`
+	Total     int                `json:"total"`
+	Limit     int                `json:"limit"`
+	Offset    int                `json:"offset"`
+}
+
+// CampaignCostResponse 캠페인 추가 적립 비용 응답
+type CampaignCostResponse struct {
+	Campaign        CampaignResponse `json:"campaign"`
+	UserCount       int64            `json:"user_count"`
+	PendingAmount   int64            `json:"pending_amount"`
+	ConfirmedAmount int64            `json:"confirmed_amount"`
+	CancelledAmount int64            `json:"cancelled_amount"`
+}
+
+// WebhookResponse 웹훅 구독 응답
+type WebhookResponse struct {
+	ID          int64     `json:"id"`
+	URL         string    `json:"url"`
+	EventTypes  []string  `json:"event_types,omitempty"`
+	Secret      string    `json:"secret,omitempty"` // 생성/비밀키 재발급 응답에만 포함
+	Description string    `json:"description,omitempty"`
+	Active      bool      `json:"active"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// WebhooksResponse 웹훅 구독 목록 응답
+type WebhooksResponse struct {
+	Webhooks []WebhookResponse `json:"webhooks"`
+	Total    int               `json:"total"`
+	Limit    int               `json:"limit"`
+	Offset   int               `json:"offset"`
+}
+
+// WebhookDeliveryResponse 웹훅 전송 내역 응답
+type WebhookDeliveryResponse struct {
+	ID             int64           `json:"id"`
+	SubscriptionID int64           `json:"subscription_id"`
+	EventID        string          `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  time.Time       `json:"next_attempt_at"`
+	LastStatusCode int             `json:"last_status_code,omitempty"`
+	LastError      string          `json:"last_error,omitempty"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+// WebhookDeliveriesResponse 웹훅 전송 내역 목록 응답
+type WebhookDeliveriesResponse struct {
+	Deliveries []WebhookDeliveryResponse `json:"deliveries"`
+	Total      int                       `json:"total"`
+	Limit      int                       `json:"limit"`
+	Offset     int                       `json:"offset"`
+}
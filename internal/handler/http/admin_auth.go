@@ -0,0 +1,38 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"shopping-mall/internal/infrastructure/auth"
+)
+
+// AdminTokenHeader 관리자 API 운영자별 인증 토큰 요청 헤더
+const AdminTokenHeader = "X-Admin-Token"
+
+// operatorContextKey 컨텍스트에 저장된 운영자 ID 키
+type operatorContextKey struct{}
+
+// AdminAuthMiddleware 관리자 API 인증 미들웨어
+// 운영자별 토큰으로 인증하고, 토큰에 등록된 운영자 ID 를 컨텍스트에 저장
+// 운영자 ID 는 요청 값으로 지정할 수 없으며, 등록된 운영자가 없으면 모든 관리자 요청을 거부
+func AdminAuthMiddleware(operators *auth.TokenSet) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			operatorID, ok := operators.Authenticate(r.Header.Get(AdminTokenHeader))
+			if !ok || operatorID == "" {
+				respondError(w, http.StatusUnauthorized, "invalid admin token")
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), operatorContextKey{}, operatorID)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// getOperatorID 인증된 운영자 ID 조회
+func getOperatorID(r *http.Request) string {
+	operatorID, _ := r.Context().Value(operatorContextKey{}).(string)
+	return operatorID
+}
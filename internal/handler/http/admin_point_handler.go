@@ -0,0 +1,209 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"shopping-mall/internal/domain/idempotency"
+	pointDomain "shopping-mall/internal/domain/point"
+	"shopping-mall/internal/handler/dto"
+	idempotencyUseCase "shopping-mall/internal/usecase/idempotency"
+	pointUseCase "shopping-mall/internal/usecase/point"
+
+	"github.com/gorilla/mux"
+)
+
+// AdminPointHandler 관리자 포인트 조정 핸들러
+type AdminPointHandler struct {
+	approvalUseCase    *pointUseCase.AdjustmentApprovalUseCase
+	idempotencyUseCase *idempotencyUseCase.ExecuteUseCase
+}
+
+// NewAdminPointHandler 관리자 포인트 조정 핸들러 생성
+func NewAdminPointHandler(
+	approvalUseCase *pointUseCase.AdjustmentApprovalUseCase,
+	idempotencyUseCase *idempotencyUseCase.ExecuteUseCase,
+) *AdminPointHandler {
+	return &AdminPointHandler{
+		approvalUseCase:    approvalUseCase,
+		idempotencyUseCase: idempotencyUseCase,
+	}
+}
+
+// CreditPoints 관리자 포인트 지급
+func (h *AdminPointHandler) CreditPoints(w http.ResponseWriter, r *http.Request) {
+	h.adjust(w, r, pointDomain.AdjustmentDirectionCredit)
+}
+
+// DebitPoints 관리자 포인트 차감
+func (h *AdminPointHandler) DebitPoints(w http.ResponseWriter, r *http.Request) {
+	h.adjust(w, r, pointDomain.AdjustmentDirectionDebit)
+}
+
+// adjust 요청 파싱 후 조정 실행 (Idempotency-Key 헤더가 있으면 재시도 중복 방지)
+// 기준 금액 이하는 바로 실행하여 200 과 거래 내역을, 초과하면 승인 요청을 만들어 202 와 승인 요청을 반환
+func (h *AdminPointHandler) adjust(w http.ResponseWriter, r *http.Request, direction pointDomain.AdjustmentDirection) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	var req dto.AdminAdjustPointsRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	adjustment := &pointDomain.AdminAdjustment{
+		UserID:        req.UserID,
+		Amount:        req.Amount,
+		Reason:        req.Reason,
+		TicketRef:     req.TicketRef,
+		OperatorID:    getOperatorID(r),
+		ExpiresInDays: req.ExpiresInDays,
+		Wallet:        pointDomain.Wallet(strings.ToUpper(req.Wallet)),
+	}
+
+	key := idempotencyKey(r, "")
+	resp, replayed, err := executeIdempotent(r, h.idempotencyUseCase, req.UserID, key, body, func(ctx context.Context) (int, interface{}, error) {
+		result, err := h.approvalUseCase.Submit(ctx, direction, adjustment)
+		if err != nil {
+			return 0, nil, err
+		}
+		if result.Approval != nil {
+			return http.StatusAccepted, toApprovalResponse(result.Approval), nil
+		}
+		return http.StatusOK, toTransactionResponse(result.Transaction), nil
+	})
+	if err != nil {
+		respondAdminError(w, err)
+		return
+	}
+
+	respondIdempotent(w, resp, replayed)
+}
+
+// ListApprovals 관리자 포인트 조정 승인 요청 목록 조회 (status 로 필터)
+func (h *AdminPointHandler) ListApprovals(w http.ResponseWriter, r *http.Request) {
+	limit, offset := getPagination(r)
+	status := pointDomain.ApprovalStatus(r.URL.Query().Get("status"))
+
+	approvals, err := h.approvalUseCase.ListApprovals(r.Context(), status, limit, offset)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	responses := make([]dto.AdjustmentApprovalResponse, len(approvals))
+	for i, approval := range approvals {
+		responses[i] = toApprovalResponse(approval)
+	}
+
+	respondJSON(w, http.StatusOK, dto.AdjustmentApprovalsResponse{
+		Approvals: responses,
+		Total:     len(responses),
+		Limit:     limit,
+		Offset:    offset,
+	})
+}
+
+// ApproveApproval 승인 요청 승인 (요청 운영자와 다른 운영자만 가능, 승인 시 조정 실행)
+func (h *AdminPointHandler) ApproveApproval(w http.ResponseWriter, r *http.Request) {
+	approvalID, req, ok := parseReviewRequest(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.approvalUseCase.Approve(r.Context(), approvalID, getOperatorID(r), req.Note)
+	if err != nil {
+		respondAdminError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toApprovalResponse(result.Approval))
+}
+
+// RejectApproval 승인 요청 반려 (요청 운영자와 다른 운영자만 가능)
+func (h *AdminPointHandler) RejectApproval(w http.ResponseWriter, r *http.Request) {
+	approvalID, req, ok := parseReviewRequest(w, r)
+	if !ok {
+		return
+	}
+
+	approval, err := h.approvalUseCase.Reject(r.Context(), approvalID, getOperatorID(r), req.Note)
+	if err != nil {
+		respondAdminError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toApprovalResponse(approval))
+}
+
+// parseReviewRequest 승인 요청 ID 와 승인/반려 요청 본문 파싱 (본문은 생략 가능)
+func parseReviewRequest(w http.ResponseWriter, r *http.Request) (int64, dto.ReviewApprovalRequest, bool) {
+	var req dto.ReviewApprovalRequest
+
+	approvalID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid approval id")
+		return 0, req, false
+	}
+
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+			respondError(w, http.StatusBadRequest, "invalid request body")
+			return 0, req, false
+		}
+	}
+
+	return approvalID, req, true
+}
+
+// respondAdminError 관리자 포인트 조정 에러 응답
+func respondAdminError(w http.ResponseWriter, err error) {
+	switch err {
+	case pointDomain.ErrInvalidUserID,
+		pointDomain.ErrInvalidAdjustmentAmount,
+		pointDomain.ErrAdjustmentReasonRequired,
+		pointDomain.ErrTicketRefRequired,
+		pointDomain.ErrOperatorRequired,
+		pointDomain.ErrInvalidExpiryDays,
+		pointDomain.ErrInvalidWallet,
+		pointDomain.ErrInsufficientPoints:
+		respondError(w, http.StatusBadRequest, err.Error())
+	case pointDomain.ErrSelfApproval:
+		respondError(w, http.StatusForbidden, err.Error())
+	case pointDomain.ErrPointNotFound, pointDomain.ErrApprovalNotFound:
+		respondError(w, http.StatusNotFound, err.Error())
+	case pointDomain.ErrApprovalNotPending, pointDomain.ErrApprovalExpired, idempotency.ErrKeyReused:
+		respondError(w, http.StatusConflict, err.Error())
+	default:
+		respondError(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
+func toApprovalResponse(approval *pointDomain.AdjustmentApproval) dto.AdjustmentApprovalResponse {
+	return dto.AdjustmentApprovalResponse{
+		ID:            approval.ID,
+		Direction:     string(approval.Direction),
+		UserID:        approval.UserID,
+		Amount:        approval.Amount,
+		Reason:        approval.Reason,
+		TicketRef:     approval.TicketRef,
+		ExpiresInDays: approval.ExpiresInDays,
+		Wallet:        string(approval.Wallet),
+		RequestedBy:   approval.RequestedBy,
+		ReviewedBy:    approval.ReviewedBy,
+		ReviewNote:    approval.ReviewNote,
+		TransactionID: approval.TransactionID,
+		Status:        string(approval.Status),
+		ExpiresAt:     approval.ExpiresAt,
+		ReviewedAt:    approval.ReviewedAt,
+		CreatedAt:     approval.CreatedAt,
+	}
+}
@@ -0,0 +1,106 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"shopping-mall/internal/infrastructure/auth"
+)
+
+// AuthorizationHeader 사용자 API 인증 헤더 ("Bearer {JWT}")
+const AuthorizationHeader = "Authorization"
+
+// APIKeyHeader 서비스 간 API 인증 키 요청 헤더
+const APIKeyHeader = "X-API-Key"
+
+// UserIDHeader 서비스 간 API 에서 대상 사용자 ID 요청 헤더
+const UserIDHeader = "X-User-ID"
+
+// errUnauthenticated 컨텍스트에 인증된 사용자가 없음
+var errUnauthenticated = errors.New("unauthenticated user")
+
+// userContextKey 컨텍스트에 저장된 사용자 ID 키
+type userContextKey struct{}
+
+// UserAuthMiddleware 사용자 API 인증 미들웨어
+// Bearer JWT(HS256/RS256)를 검증하고 sub 클레임을 사용자 ID 로 컨텍스트에 저장
+func UserAuthMiddleware(verifier *auth.Verifier) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, ok := bearerToken(r)
+			if !ok {
+				respondError(w, http.StatusUnauthorized, "bearer token is required")
+				return
+			}
+
+			claims, err := verifier.Verify(token, time.Now())
+			if err != nil {
+				respondError(w, http.StatusUnauthorized, err.Error())
+				return
+			}
+
+			userID, err := strconv.ParseInt(claims.Subject, 10, 64)
+			if err != nil || userID <= 0 {
+				respondError(w, http.StatusUnauthorized, "invalid token subject")
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), userContextKey{}, userID)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// ServiceAuthMiddleware 서비스 간 API 인증 미들웨어
+// X-API-Key 가 있으면 API 키로, 없으면 mTLS 클라이언트 인증서로 서비스를 인증하고
+// X-User-ID 헤더의 사용자 ID 를 컨텍스트에 저장
+func ServiceAuthMiddleware(authenticator *auth.ServiceAuthenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var ok bool
+			if key := r.Header.Get(APIKeyHeader); key != "" {
+				_, ok = authenticator.AuthenticateAPIKey(key)
+			} else {
+				_, ok = authenticator.AuthenticateCertificate(r.TLS)
+			}
+			if !ok {
+				respondError(w, http.StatusUnauthorized, "invalid service credentials")
+				return
+			}
+
+			ctx := r.Context()
+			if v := r.Header.Get(UserIDHeader); v != "" {
+				userID, err := strconv.ParseInt(v, 10, 64)
+				if err != nil || userID <= 0 {
+					respondError(w, http.StatusBadRequest, "invalid "+UserIDHeader)
+					return
+				}
+				ctx = context.WithValue(ctx, userContextKey{}, userID)
+			}
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// getUserID 인증된 사용자 ID 조회 (인증 미들웨어가 저장한 값만 사용)
+func getUserID(r *http.Request) (int64, error) {
+	userID, ok := r.Context().Value(userContextKey{}).(int64)
+	if !ok {
+		return 0, errUnauthenticated
+	}
+	return userID, nil
+}
+
+// bearerToken Authorization 헤더의 Bearer 토큰 추출
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get(AuthorizationHeader), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
@@ -0,0 +1,140 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	pointDomain "shopping-mall/internal/domain/point"
+	"shopping-mall/internal/handler/dto"
+	pointUseCase "shopping-mall/internal/usecase/point"
+
+	"github.com/gorilla/mux"
+)
+
+// CampaignHandler 프로모션 캠페인 관리 핸들러
+type CampaignHandler struct {
+	campaignUseCase *pointUseCase.CampaignUseCase
+}
+
+// NewCampaignHandler 캠페인 관리 핸들러 생성
+func NewCampaignHandler(campaignUseCase *pointUseCase.CampaignUseCase) *CampaignHandler {
+	return &CampaignHandler{
+		campaignUseCase: campaignUseCase,
+	}
+}
+
+// CreateCampaign 캠페인 생성
+func (h *CampaignHandler) CreateCampaign(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateCampaignRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	campaign := &pointDomain.Campaign{
+		Name:             req.Name,
+		BonusType:        pointDomain.CampaignBonusType(req.BonusType),
+		BonusValue:       req.BonusValue,
+		StartsAt:         req.StartsAt,
+		EndsAt:           req.EndsAt,
+		Wallet:           pointDomain.Wallet(strings.ToUpper(req.Wallet)),
+		Categories:       req.Categories,
+		MinPaymentAmount: req.MinPaymentAmount,
+		UserBudget:       req.UserBudget,
+		TotalBudget:      req.TotalBudget,
+	}
+	for _, tier := range req.Tiers {
+		campaign.Tiers = append(campaign.Tiers, pointDomain.Tier(tier))
+	}
+	for _, reasonType := range req.ReasonTypes {
+		campaign.ReasonTypes = append(campaign.ReasonTypes, pointDomain.ReasonType(reasonType))
+	}
+
+	if err := h.campaignUseCase.CreateCampaign(r.Context(), campaign); err != nil {
+		if err == pointDomain.ErrInvalidCampaign {
+			respondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, toCampaignResponse(campaign))
+}
+
+// ListCampaigns 캠페인 목록 조회
+func (h *CampaignHandler) ListCampaigns(w http.ResponseWriter, r *http.Request) {
+	limit, offset := getPagination(r)
+
+	campaigns, err := h.campaignUseCase.ListCampaigns(r.Context(), limit, offset)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	responses := make([]dto.CampaignResponse, len(campaigns))
+	for i, campaign := range campaigns {
+		responses[i] = toCampaignResponse(campaign)
+	}
+
+	respondJSON(w, http.StatusOK, dto.CampaignsResponse{
+		Campaigns: responses,
+		Total:     len(responses),
+		Limit:     limit,
+		Offset:    offset,
+	})
+}
+
+// GetCampaignCost 캠페인 추가 적립 비용 조회
+func (h *CampaignHandler) GetCampaignCost(w http.ResponseWriter, r *http.Request) {
+	campaignID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid campaign id")
+		return
+	}
+
+	campaign, cost, err := h.campaignUseCase.GetCampaignCost(r.Context(), campaignID)
+	if err != nil {
+		if err == pointDomain.ErrCampaignNotFound {
+			respondError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondJSON(w, http.StatusOK, dto.CampaignCostResponse{
+		Campaign:        toCampaignResponse(campaign),
+		UserCount:       cost.UserCount,
+		PendingAmount:   cost.PendingAmount,
+		ConfirmedAmount: cost.ConfirmedAmount,
+		CancelledAmount: cost.CancelledAmount,
+	})
+}
+
+func toCampaignResponse(campaign *pointDomain.Campaign) dto.CampaignResponse {
+	resp := dto.CampaignResponse{
+		ID:               campaign.ID,
+		Name:             campaign.Name,
+		BonusType:        string(campaign.BonusType),
+		BonusValue:       campaign.BonusValue,
+		StartsAt:         campaign.StartsAt,
+		EndsAt:           campaign.EndsAt,
+		Wallet:           string(campaign.Wallet),
+		Categories:       campaign.Categories,
+		MinPaymentAmount: campaign.MinPaymentAmount,
+		UserBudget:       campaign.UserBudget,
+		TotalBudget:      campaign.TotalBudget,
+		SpentBudget:      campaign.SpentBudget,
+		CreatedAt:        campaign.CreatedAt,
+	}
+	for _, tier := range campaign.Tiers {
+		resp.Tiers = append(resp.Tiers, string(tier))
+	}
+	for _, reasonType := range campaign.ReasonTypes {
+		resp.ReasonTypes = append(resp.ReasonTypes, string(reasonType))
+	}
+	return resp
+}
@@ -0,0 +1,89 @@
+package http
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"shopping-mall/internal/domain/idempotency"
+	idempotencyUseCase "shopping-mall/internal/usecase/idempotency"
+)
+
+// IdempotencyKeyHeader 멱등성 키 요청 헤더
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// idempotentReplayedHeader 기록된 응답을 재전송했음을 알리는 응답 헤더
+const idempotentReplayedHeader = "Idempotent-Replayed"
+
+// idempotentHandlerFunc 멱등성 키로 보호되는 요청 처리 함수 (상태 코드, 응답 데이터 반환)
+type idempotentHandlerFunc func(ctx context.Context) (int, interface{}, error)
+
+// orderNaturalKey 주문 단위 자연 키 (Idempotency-Key 헤더가 없을 때 사용)
+func orderNaturalKey(orderID int64) string {
+	if orderID <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("order:%d", orderID)
+}
+
+// idempotencyKey 요청 헤더의 키, 없으면 자연 키 반환
+func idempotencyKey(r *http.Request, naturalKey string) string {
+	if key := r.Header.Get(IdempotencyKeyHeader); key != "" {
+		return key
+	}
+	return naturalKey
+}
+
+// requestHash 요청 페이로드 해시
+func requestHash(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+// executeIdempotent 멱등성 키가 있으면 키 선점/응답 기록과 함께 fn 실행, 재요청이면 기록된 응답 반환
+// 키가 없으면 fn 을 그대로 실행
+func executeIdempotent(
+	r *http.Request,
+	uc *idempotencyUseCase.ExecuteUseCase,
+	userID int64,
+	key string,
+	body []byte,
+	fn idempotentHandlerFunc,
+) (*idempotency.Response, bool, error) {
+	run := func(ctx context.Context) (*idempotency.Response, error) {
+		status, data, err := fn(ctx)
+		if err != nil {
+			return nil, err
+		}
+		encoded, err := json.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+		return &idempotency.Response{Status: status, Body: encoded}, nil
+	}
+
+	if uc == nil || key == "" {
+		resp, err := run(r.Context())
+		return resp, false, err
+	}
+
+	// 관리자 요청은 인증된 운영자별로 키를 구분 (다른 운영자의 조정 응답을 재생해 감사 기록이 섞이지 않도록)
+	scope := fmt.Sprintf("%s %s user:%d", r.Method, r.URL.Path, userID)
+	if operatorID := getOperatorID(r); operatorID != "" {
+		scope += " operator:" + operatorID
+	}
+	return uc.Execute(r.Context(), scope, key, requestHash(body), run)
+}
+
+// respondIdempotent 멱등성 실행 결과 응답
+func respondIdempotent(w http.ResponseWriter, resp *idempotency.Response, replayed bool) {
+	w.Header().Set("Content-Type", "application/json")
+	if replayed {
+		w.Header().Set(idempotentReplayedHeader, "true")
+	}
+	w.WriteHeader(resp.Status)
+	w.Write(append(resp.Body, '\n'))
+}
@@ -1,37 +1,143 @@
 package http
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 
+	"shopping-mall/internal/domain/idempotency"
+	orderDomain "shopping-mall/internal/domain/order"
+	pointDomain "shopping-mall/internal/domain/point"
 	"shopping-mall/internal/handler/dto"
-	pointUseCase "shopping-mall/internal/usecase/point"
+	idempotencyUseCase "shopping-mall/internal/usecase/idempotency"
+	orderUseCase "shopping-mall/internal/usecase/order"
 
 	"github.com/gorilla/mux"
 )
 
 // OrderHandler 주문 핸들러 (포인트 관련)
+// 주문 상태 전이를 포인트 거래와 같은 트랜잭션으로 기록
 type OrderHandler struct {
-	useUseCase    *pointUseCase.UsePointsUseCase
-	earnUseCase   *pointUseCase.EarnPointsUseCase
-	refundUseCase *pointUseCase.RefundPointsUseCase
+	transitionUseCase  *orderUseCase.TransitionUseCase
+	idempotencyUseCase *idempotencyUseCase.ExecuteUseCase
 }
 
 // NewOrderHandler 주문 핸들러 생성
 func NewOrderHandler(
-	useUseCase *pointUseCase.UsePointsUseCase,
-	earnUseCase *pointUseCase.EarnPointsUseCase,
-	refundUseCase *pointUseCase.RefundPointsUseCase,
+	transitionUseCase *orderUseCase.TransitionUseCase,
+	idempotencyUseCase *idempotencyUseCase.ExecuteUseCase,
 ) *OrderHandler {
 	return &OrderHandler{
-		useUseCase:    useUseCase,
-		earnUseCase:   earnUseCase,
-		refundUseCase: refundUseCase,
+		transitionUseCase:  transitionUseCase,
+		idempotencyUseCase: idempotencyUseCase,
 	}
 }
 
-// ConfirmOrder 주문 확정 (포인트 적립)
+// GetOrder 주문 조회 (상태, 사용/적립 예정 포인트)
+func (h *OrderHandler) GetOrder(w http.ResponseWriter, r *http.Request) {
+	orderID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid order_id")
+		return
+	}
+
+	userID, err := getUserID(r)
+	if err != nil {
+		respondError(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	o, err := h.transitionUseCase.GetOrder(r.Context(), userID, orderID)
+	if err != nil {
+		respondOrderError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toOrderResponse(o))
+}
+
+// PayOrder 주문 결제 완료 (사용 포인트 차감)
+func (h *OrderHandler) PayOrder(w http.ResponseWriter, r *http.Request) {
+	orderID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid order_id")
+		return
+	}
+
+	userID, err := getUserID(r)
+	if err != nil {
+		respondError(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	var req dto.PayOrderRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	key := idempotencyKey(r, orderNaturalKey(orderID))
+	resp, replayed, err := executeIdempotent(r, h.idempotencyUseCase, userID, key, body, func(ctx context.Context) (int, interface{}, error) {
+		o, err := h.transitionUseCase.Pay(ctx, userID, orderID, req.OrderAmount, req.PointUsed)
+		if err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, toOrderResponse(o), nil
+	})
+	if err != nil {
+		respondOrderError(w, err)
+		return
+	}
+
+	respondIdempotent(w, resp, replayed)
+}
+
+// CancelOrder 구매 확정 전 주문 취소 (사용 포인트 복구)
+func (h *OrderHandler) CancelOrder(w http.ResponseWriter, r *http.Request) {
+	orderID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid order_id")
+		return
+	}
+
+	userID, err := getUserID(r)
+	if err != nil {
+		respondError(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	key := idempotencyKey(r, orderNaturalKey(orderID))
+	resp, replayed, err := executeIdempotent(r, h.idempotencyUseCase, userID, key, body, func(ctx context.Context) (int, interface{}, error) {
+		o, err := h.transitionUseCase.Cancel(ctx, userID, orderID)
+		if err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, toOrderResponse(o), nil
+	})
+	if err != nil {
+		respondOrderError(w, err)
+		return
+	}
+
+	respondIdempotent(w, resp, replayed)
+}
+
+// ConfirmOrder 주문 확정 (적립 예정 포인트 등록)
 func (h *OrderHandler) ConfirmOrder(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	orderIDStr := vars["id"]
@@ -43,24 +149,36 @@ func (h *OrderHandler) ConfirmOrder(w http.ResponseWriter, r *http.Request) {
 
 	userID, err := getUserID(r)
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "invalid user_id")
+		respondError(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
 	var req dto.EarnPointsRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+	if err := json.Unmarshal(body, &req); err != nil {
 		respondError(w, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
 	req.OrderID = orderID
-	ctx := r.Context()
-	if err := h.earnUseCase.EarnPointsFromPurchase(ctx, userID, req.PaymentAmount, req.OrderID); err != nil {
-		respondError(w, http.StatusInternalServerError, err.Error())
+	key := idempotencyKey(r, orderNaturalKey(orderID))
+	resp, replayed, err := executeIdempotent(r, h.idempotencyUseCase, userID, key, body, func(ctx context.Context) (int, interface{}, error) {
+		if _, err := h.transitionUseCase.Confirm(ctx, userID, toPurchaseOrder(&req)); err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, map[string]string{"message": "order confirmed and points scheduled"}, nil
+	})
+	if err != nil {
+		respondOrderError(w, err)
 		return
 	}
 
-	respondJSON(w, http.StatusOK, map[string]string{"message": "order confirmed and points earned"})
+	respondIdempotent(w, resp, replayed)
 }
 
 // RefundOrder 주문 환불 (포인트 복구/회수)
@@ -75,15 +193,176 @@ func (h *OrderHandler) RefundOrder(w http.ResponseWriter, r *http.Request) {
 
 	userID, err := getUserID(r)
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "invalid user_id")
+		respondError(w, http.StatusUnauthorized, err.Error())
 		return
 	}
 
-	ctx := r.Context()
-	if err := h.refundUseCase.RefundPoints(ctx, userID, orderID); err != nil {
-		respondError(w, http.StatusInternalServerError, err.Error())
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	key := idempotencyKey(r, orderNaturalKey(orderID))
+	resp, replayed, err := executeIdempotent(r, h.idempotencyUseCase, userID, key, body, func(ctx context.Context) (int, interface{}, error) {
+		if _, err := h.transitionUseCase.Refund(ctx, userID, orderID); err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, map[string]string{"message": "order refunded and points processed"}, nil
+	})
+	if err != nil {
+		respondOrderError(w, err)
+		return
+	}
+
+	respondIdempotent(w, resp, replayed)
+}
+
+// PartialRefundOrder 주문 부분 환불 (환불 결제 금액 비율로 포인트 복구/회수)
+func (h *OrderHandler) PartialRefundOrder(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	orderIDStr := vars["id"]
+	orderID, err := strconv.ParseInt(orderIDStr, 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid order_id")
+		return
+	}
+
+	userID, err := getUserID(r)
+	if err != nil {
+		respondError(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	var req dto.PartialRefundRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	// 부분 환불은 주문당 여러 번 가능하므로 환불 ID 단위 자연 키 사용
+	var naturalKey string
+	if req.RefundID != "" {
+		naturalKey = fmt.Sprintf("order:%d:refund:%s", orderID, req.RefundID)
+	}
+
+	key := idempotencyKey(r, naturalKey)
+	resp, replayed, err := executeIdempotent(r, h.idempotencyUseCase, userID, key, body, func(ctx context.Context) (int, interface{}, error) {
+		refund, err := h.transitionUseCase.RefundPartial(ctx, userID, orderID, req.PaymentAmount, req.RefundPaymentAmount, req.RefundPointAmount, toRefundLines(req.Lines))
+		if err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, toPartialRefundResponse(refund), nil
+	})
+	if err != nil {
+		respondOrderError(w, err)
 		return
 	}
 
-	respondJSON(w, http.StatusOK, map[string]string{"message": "order refunded and points processed"})
+	respondIdempotent(w, resp, replayed)
+}
+
+// respondOrderError 주문 처리 오류 응답
+func respondOrderError(w http.ResponseWriter, err error) {
+	switch err {
+	case pointDomain.ErrPointNotFound:
+		respondError(w, http.StatusNotFound, "point not found")
+	case orderDomain.ErrOrderNotFound, pointDomain.ErrOrderLineNotFound:
+		respondError(w, http.StatusNotFound, err.Error())
+	case pointDomain.ErrInsufficientPoints,
+		pointDomain.ErrBelowMinUseAmount,
+		pointDomain.ErrInvalidUseUnit,
+		pointDomain.ErrExceedMaxUseRate,
+		pointDomain.ErrBelowMinPayment,
+		pointDomain.ErrInvalidLineItem,
+		pointDomain.ErrInvalidRefundAmount,
+		pointDomain.ErrRefundExceedsPayment,
+		pointDomain.ErrRefundExceedsUsedPoints,
+		pointDomain.ErrRefundPaymentMismatch,
+		pointDomain.ErrRefundExceedsQuantity,
+		orderDomain.ErrInvalidOrderAmount,
+		orderDomain.ErrTotalMismatch:
+		respondError(w, http.StatusBadRequest, err.Error())
+	case pointDomain.ErrOrderAlreadyUsed,
+		pointDomain.ErrOrderAlreadyEarned,
+		pointDomain.ErrOrderAlreadyRefunded,
+		orderDomain.ErrOrderCancelled,
+		orderDomain.ErrInvalidTransition,
+		orderDomain.ErrPointTotalsMismatch,
+		idempotency.ErrKeyReused:
+		respondError(w, http.StatusConflict, err.Error())
+	default:
+		respondError(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
+func toOrderResponse(o *orderDomain.Order) dto.OrderResponse {
+	return dto.OrderResponse{
+		OrderID:       o.ID,
+		UserID:        o.UserID,
+		Status:        string(o.Status),
+		TotalAmount:   o.TotalAmount,
+		PointUsed:     o.PointUsed,
+		PointToEarn:   o.PointToEarn,
+		PaymentAmount: o.PaymentAmount,
+		ConfirmedAt:   o.ConfirmedAt,
+		CreatedAt:     o.CreatedAt,
+		UpdatedAt:     o.UpdatedAt,
+	}
+}
+
+func toPartialRefundResponse(refund *pointDomain.OrderRefund) dto.PartialRefundResponse {
+	return dto.PartialRefundResponse{
+		OrderID:                refund.OrderID,
+		PaymentAmount:          refund.PaymentAmount,
+		RefundedPaymentAmount:  refund.RefundedPaymentAmount,
+		RemainingPaymentAmount: refund.RemainingPaymentAmount(),
+		UsedPointAmount:        refund.UsedPointAmount,
+		RestoredPointAmount:    refund.RestoredPointAmount,
+		EarnedPointAmount:      refund.EarnedPointAmount,
+		ClawedBackPointAmount:  refund.ClawedBackPointAmount,
+	}
+}
+
+// toPurchaseOrder 적립 요청을 구매 적립 대상 주문으로 변환
+func toPurchaseOrder(req *dto.EarnPointsRequest) *pointDomain.PurchaseOrder {
+	return &pointDomain.PurchaseOrder{
+		OrderID:       req.OrderID,
+		PaymentAmount: req.PaymentAmount,
+		Categories:    req.Categories,
+		Items:         toLineItems(req.Items),
+	}
+}
+
+// toLineItems 주문 라인 요청 변환 (point_eligible 이 없으면 적립 대상)
+func toLineItems(reqs []dto.LineItemRequest) []pointDomain.LineItem {
+	var items []pointDomain.LineItem
+	for _, item := range reqs {
+		eligible := item.PointEligible == nil || *item.PointEligible
+		items = append(items, pointDomain.LineItem{
+			LineNo:        item.LineNo,
+			SKU:           item.SKU,
+			Category:      item.Category,
+			Quantity:      item.Quantity,
+			UnitPrice:     item.UnitPrice,
+			Discount:      item.Discount,
+			PointEligible: eligible,
+		})
+	}
+	return items
+}
+
+// toRefundLines 부분 환불 라인 요청 변환
+func toRefundLines(reqs []dto.RefundLineRequest) []pointDomain.RefundLine {
+	var lines []pointDomain.RefundLine
+	for _, req := range reqs {
+		lines = append(lines, pointDomain.RefundLine{LineNo: req.LineNo, Quantity: req.Quantity})
+	}
+	return lines
 }
@@ -1,23 +1,26 @@
 package http
 
 import (
+	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"strconv"
 	"time"
 
+	"shopping-mall/internal/domain/idempotency"
 	pointDomain "shopping-mall/internal/domain/point"
 	"shopping-mall/internal/handler/dto"
+	idempotencyUseCase "shopping-mall/internal/usecase/idempotency"
 	pointUseCase "shopping-mall/internal/usecase/point"
-
-	"github.com/gorilla/mux"
 )
 
 // PointHandler 포인트 핸들러
 type PointHandler struct {
-	queryUseCase *pointUseCase.QueryPointsUseCase
-	useUseCase   *pointUseCase.UsePointsUseCase
-	earnUseCase  *pointUseCase.EarnPointsUseCase
+	queryUseCase       *pointUseCase.QueryPointsUseCase
+	useUseCase         *pointUseCase.UsePointsUseCase
+	earnUseCase        *pointUseCase.EarnPointsUseCase
+	idempotencyUseCase *idempotencyUseCase.ExecuteUseCase
 }
 
 // NewPointHandler 포인트 핸들러 생성
@@ -25,11 +28,13 @@ func NewPointHandler(
 	queryUseCase *pointUseCase.QueryPointsUseCase,
 	useUseCase *pointUseCase.UsePointsUseCase,
 	earnUseCase *pointUseCase.EarnPointsUseCase,
+	idempotencyUseCase *idempotencyUseCase.ExecuteUseCase,
 ) *PointHandler {
 	return &PointHandler{
-		queryUseCase: queryUseCase,
-		useUseCase:   useUseCase,
-		earnUseCase:  earnUseCase,
+		queryUseCase:       queryUseCase,
+		useUseCase:         useUseCase,
+		earnUseCase:        earnUseCase,
+		idempotencyUseCase: idempotencyUseCase,
 	}
 }
 
@@ -37,7 +42,7 @@ func NewPointHandler(
 func (h *PointHandler) GetBalance(w http.ResponseWriter, r *http.Request) {
 	userID, err := getUserID(r)
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "invalid user_id")
+		respondError(w, http.StatusUnauthorized, err.Error())
 		return
 	}
 
@@ -52,21 +57,67 @@ func (h *PointHandler) GetBalance(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	membership, err := h.queryUseCase.GetMembership(ctx, userID)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
 	respondJSON(w, http.StatusOK, dto.BalanceResponse{
 		UserID:           userPoint.UserID,
 		AvailableBalance: userPoint.AvailableBalance,
 		PendingBalance:   userPoint.PendingBalance,
+		HeldBalance:      userPoint.HeldBalance,
+		DebtBalance:      userPoint.DebtBalance,
 		TotalEarned:      userPoint.TotalEarned,
 		TotalUsed:        userPoint.TotalUsed,
 		UpdatedAt:        userPoint.UpdatedAt,
+		Tier:             string(membership.Tier),
+		EarnRate:         membership.Policy.EarnRate,
+		MaxEarnPerOrder:  membership.Policy.MaxEarnPerOrder,
+		MaxUseRate:       membership.Policy.MaxUseRate,
+		WalletBalances:   toWalletBalances(userPoint.WalletBalances),
 	})
 }
 
+// Quote 결제 전 포인트 견적 조회 (최대 사용 가능 포인트 / 적립 예정 포인트)
+func (h *PointHandler) Quote(w http.ResponseWriter, r *http.Request) {
+	userID, err := getUserID(r)
+	if err != nil {
+		respondError(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	var req dto.QuoteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	order := &pointDomain.PurchaseOrder{
+		PaymentAmount: req.OrderAmount,
+		Categories:    req.Categories,
+		Items:         toLineItems(req.Items),
+	}
+	quote, err := h.queryUseCase.Quote(r.Context(), userID, order, req.UseAmount)
+	if err != nil {
+		switch err {
+		case pointDomain.ErrInvalidQuoteAmount, pointDomain.ErrInvalidLineItem:
+			respondError(w, http.StatusBadRequest, err.Error())
+		default:
+			respondError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toQuoteResponse(userID, quote))
+}
+
 // GetTransactions 거래 내역 조회
 func (h *PointHandler) GetTransactions(w http.ResponseWriter, r *http.Request) {
 	userID, err := getUserID(r)
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "invalid user_id")
+		respondError(w, http.StatusUnauthorized, err.Error())
 		return
 	}
 
@@ -92,22 +143,64 @@ func (h *PointHandler) GetTransactions(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// GetDebtReport 포인트 부채 현황 조회
+func (h *PointHandler) GetDebtReport(w http.ResponseWriter, r *http.Request) {
+	limit, offset := getPagination(r)
+
+	ctx := r.Context()
+	report, err := h.queryUseCase.GetDebtReport(ctx, limit, offset)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	users := make([]dto.DebtUserResponse, len(report.Users))
+	for i, up := range report.Users {
+		users[i] = dto.DebtUserResponse{
+			UserID:           up.UserID,
+			AvailableBalance: up.AvailableBalance,
+			DebtBalance:      up.DebtBalance,
+			UpdatedAt:        up.UpdatedAt,
+		}
+	}
+
+	respondJSON(w, http.StatusOK, dto.DebtReportResponse{
+		UserCount: report.UserCount,
+		TotalDebt: report.TotalDebt,
+		Users:     users,
+		Limit:     limit,
+		Offset:    offset,
+	})
+}
+
 // UsePoints 포인트 사용
 func (h *PointHandler) UsePoints(w http.ResponseWriter, r *http.Request) {
 	userID, err := getUserID(r)
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "invalid user_id")
+		respondError(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
 	var req dto.UsePointsRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+	if err := json.Unmarshal(body, &req); err != nil {
 		respondError(w, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
-	ctx := r.Context()
-	if err := h.useUseCase.UsePoints(ctx, userID, req.UseAmount, req.OrderAmount, req.OrderID); err != nil {
+	key := idempotencyKey(r, orderNaturalKey(req.OrderID))
+	resp, replayed, err := executeIdempotent(r, h.idempotencyUseCase, userID, key, body, func(ctx context.Context) (int, interface{}, error) {
+		if err := h.useUseCase.UsePoints(ctx, userID, req.UseAmount, req.OrderAmount, req.OrderID); err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, map[string]string{"message": "points used successfully"}, nil
+	})
+	if err != nil {
 		switch err {
 		case pointDomain.ErrInsufficientPoints:
 			respondError(w, http.StatusBadRequest, "insufficient points")
@@ -119,58 +212,61 @@ func (h *PointHandler) UsePoints(w http.ResponseWriter, r *http.Request) {
 			respondError(w, http.StatusBadRequest, "exceed maximum use rate")
 		case pointDomain.ErrBelowMinPayment:
 			respondError(w, http.StatusBadRequest, "below minimum payment amount")
+		case pointDomain.ErrOrderAlreadyUsed, idempotency.ErrKeyReused:
+			respondError(w, http.StatusConflict, err.Error())
 		default:
 			respondError(w, http.StatusInternalServerError, err.Error())
 		}
 		return
 	}
 
-	respondJSON(w, http.StatusOK, map[string]string{"message": "points used successfully"})
+	respondIdempotent(w, resp, replayed)
 }
 
 // EarnPoints 포인트 적립
 func (h *PointHandler) EarnPoints(w http.ResponseWriter, r *http.Request) {
 	userID, err := getUserID(r)
 	if err != nil {
-		respondError(w, http.StatusBadRequest, "invalid user_id")
+		respondError(w, http.StatusUnauthorized, err.Error())
 		return
 	}
 
-	var req dto.EarnPointsRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
 		respondError(w, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
-	ctx := r.Context()
-	if err := h.earnUseCase.EarnPointsFromPurchase(ctx, userID, req.PaymentAmount, req.OrderID); err != nil {
-		respondError(w, http.StatusInternalServerError, err.Error())
+	var req dto.EarnPointsRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
 		return
 	}
 
-	respondJSON(w, http.StatusOK, map[string]string{"message": "points earned successfully"})
-}
-
-// Helper functions
-
-func getUserID(r *http.Request) (int64, error) {
-	vars := mux.Vars(r)
-	userIDStr, ok := vars["user_id"]
-	if !ok {
-		// Query parameter에서 시도
-		userIDStr = r.URL.Query().Get("user_id")
-		if userIDStr == "" {
-			return 0, http.ErrMissingFile
+	key := idempotencyKey(r, orderNaturalKey(req.OrderID))
+	resp, replayed, err := executeIdempotent(r, h.idempotencyUseCase, userID, key, body, func(ctx context.Context) (int, interface{}, error) {
+		if err := h.earnUseCase.EarnPointsFromPurchase(ctx, userID, toPurchaseOrder(&req)); err != nil {
+			return 0, nil, err
 		}
-	}
-
-	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+		return http.StatusOK, map[string]string{"message": "points scheduled successfully"}, nil
+	})
 	if err != nil {
-		return 0, err
+		switch err {
+		case pointDomain.ErrInvalidLineItem:
+			respondError(w, http.StatusBadRequest, err.Error())
+		case pointDomain.ErrOrderAlreadyEarned, idempotency.ErrKeyReused:
+			respondError(w, http.StatusConflict, err.Error())
+		default:
+			respondError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
 	}
-	return userID, nil
+
+	respondIdempotent(w, resp, replayed)
 }
 
+// Helper functions
+
 func getPagination(r *http.Request) (limit, offset int) {
 	limitStr := r.URL.Query().Get("limit")
 	offsetStr := r.URL.Query().Get("offset")
@@ -193,6 +289,50 @@ func getPagination(r *http.Request) (limit, offset int) {
 	return limit, offset
 }
 
+func toWalletBalances(balances map[pointDomain.Wallet]int64) map[string]int64 {
+	resp := make(map[string]int64, len(pointDomain.Wallets))
+	for _, wallet := range pointDomain.Wallets {
+		resp[string(wallet)] = balances[wallet]
+	}
+	return resp
+}
+
+func toQuoteResponse(userID int64, quote *pointUseCase.CheckoutQuote) dto.QuoteResponse {
+	resp := dto.QuoteResponse{
+		UserID:           userID,
+		Tier:             string(quote.Tier),
+		AvailableBalance: quote.AvailableBalance,
+		OrderAmount:      quote.OrderAmount,
+		MaxUsableAmount:  quote.MaxUsableAmount,
+		UseLimit:         string(quote.UseLimit),
+		UseAmount:        quote.UseAmount,
+		Usable:           quote.UseError == nil,
+		EarnableAmount:   quote.EarnableAmount,
+		EarnPoints:       quote.EarnPoints,
+		CampaignPoints:   quote.CampaignPoints,
+		TotalEarnPoints:  quote.TotalEarnPoints,
+	}
+	if quote.UseError != nil {
+		resp.UseError = quote.UseError.Error()
+	}
+	for _, bonus := range quote.CampaignBonuses {
+		resp.Campaigns = append(resp.Campaigns, dto.CampaignBonusResponse{
+			CampaignID: bonus.CampaignID,
+			Name:       bonus.Name,
+			Points:     bonus.Points,
+		})
+	}
+	for _, earn := range quote.LineEarns {
+		resp.Lines = append(resp.Lines, dto.LineEarnResponse{
+			LineNo:     earn.Item.LineNo,
+			SKU:        earn.Item.SKU,
+			EarnRate:   earn.Rate,
+			EarnPoints: earn.Points,
+		})
+	}
+	return resp
+}
+
 func toTransactionResponse(tx *pointDomain.Transaction) dto.TransactionResponse {
 	resp := dto.TransactionResponse{
 		ID:           tx.ID,
@@ -202,6 +342,10 @@ func toTransactionResponse(tx *pointDomain.Transaction) dto.TransactionResponse
 		BalanceAfter: tx.BalanceAfter,
 		ReasonType:   string(tx.ReasonType),
 		ReasonDetail: tx.ReasonDetail,
+		OperatorID:   tx.OperatorID,
+		TicketRef:    tx.TicketRef,
+		CampaignID:   tx.CampaignID,
+		Wallet:       string(tx.Wallet),
 		OrderID:      tx.OrderID,
 		Expired:      tx.Expired,
 		Status:       string(tx.Status),
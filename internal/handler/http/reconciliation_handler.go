@@ -0,0 +1,66 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	pointDomain "shopping-mall/internal/domain/point"
+	"shopping-mall/internal/handler/dto"
+	pointUseCase "shopping-mall/internal/usecase/point"
+)
+
+// ReconciliationHandler 포인트 정합성 검증 핸들러
+type ReconciliationHandler struct {
+	reconcileUseCase *pointUseCase.ReconcilePointsUseCase
+}
+
+// NewReconciliationHandler 포인트 정합성 검증 핸들러 생성
+func NewReconciliationHandler(reconcileUseCase *pointUseCase.ReconcilePointsUseCase) *ReconciliationHandler {
+	return &ReconciliationHandler{
+		reconcileUseCase: reconcileUseCase,
+	}
+}
+
+// ReconcileUser 사용자 잔액 정합성 검증 (auto_correct=true 이면 보정 거래로 자동 보정)
+func (h *ReconciliationHandler) ReconcileUser(w http.ResponseWriter, r *http.Request) {
+	userID, err := getUserID(r)
+	if err != nil {
+		respondError(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	var autoCorrect bool
+	if v := r.URL.Query().Get("auto_correct"); v != "" {
+		autoCorrect, err = strconv.ParseBool(v)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "invalid auto_correct")
+			return
+		}
+	}
+
+	reconciliation, err := h.reconcileUseCase.ReconcileUser(r.Context(), userID, autoCorrect)
+	if err != nil {
+		if err == pointDomain.ErrPointNotFound {
+			respondError(w, http.StatusNotFound, "point not found")
+			return
+		}
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondJSON(w, http.StatusOK, dto.ReconciliationResponse{
+		UserID:                 reconciliation.UserID,
+		Consistent:             !reconciliation.HasDiscrepancy(),
+		Corrected:              reconciliation.Corrected,
+		ExpectedNetBalance:     reconciliation.ExpectedNetBalance,
+		ActualNetBalance:       reconciliation.ActualNetBalance,
+		ExpectedPendingBalance: reconciliation.ExpectedPendingBalance,
+		ActualPendingBalance:   reconciliation.ActualPendingBalance,
+		ExpectedHeldBalance:    reconciliation.ExpectedHeldBalance,
+		ActualHeldBalance:      reconciliation.ActualHeldBalance,
+		ExpectedTotalEarned:    reconciliation.ExpectedTotalEarned,
+		ActualTotalEarned:      reconciliation.ActualTotalEarned,
+		ExpectedTotalUsed:      reconciliation.ExpectedTotalUsed,
+		ActualTotalUsed:        reconciliation.ActualTotalUsed,
+	})
+}
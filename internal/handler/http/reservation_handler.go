@@ -0,0 +1,158 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"shopping-mall/internal/domain/idempotency"
+	pointDomain "shopping-mall/internal/domain/point"
+	"shopping-mall/internal/handler/dto"
+	idempotencyUseCase "shopping-mall/internal/usecase/idempotency"
+	pointUseCase "shopping-mall/internal/usecase/point"
+
+	"github.com/gorilla/mux"
+)
+
+// ReservationHandler 포인트 예약 핸들러 (결제 전 hold / capture / release)
+type ReservationHandler struct {
+	reserveUseCase     *pointUseCase.ReservePointsUseCase
+	idempotencyUseCase *idempotencyUseCase.ExecuteUseCase
+}
+
+// NewReservationHandler 포인트 예약 핸들러 생성
+func NewReservationHandler(
+	reserveUseCase *pointUseCase.ReservePointsUseCase,
+	idempotencyUseCase *idempotencyUseCase.ExecuteUseCase,
+) *ReservationHandler {
+	return &ReservationHandler{
+		reserveUseCase:     reserveUseCase,
+		idempotencyUseCase: idempotencyUseCase,
+	}
+}
+
+// ReservePoints 포인트 예약
+func (h *ReservationHandler) ReservePoints(w http.ResponseWriter, r *http.Request) {
+	userID, err := getUserID(r)
+	if err != nil {
+		respondError(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	var req dto.ReservePointsRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	key := idempotencyKey(r, orderNaturalKey(req.OrderID))
+	resp, replayed, err := executeIdempotent(r, h.idempotencyUseCase, userID, key, body, func(ctx context.Context) (int, interface{}, error) {
+		reservation, err := h.reserveUseCase.Reserve(ctx, userID, req.UseAmount, req.OrderAmount, req.OrderID)
+		if err != nil {
+			return 0, nil, err
+		}
+		return http.StatusCreated, toReservationResponse(reservation), nil
+	})
+	if err != nil {
+		respondReservationError(w, err)
+		return
+	}
+
+	respondIdempotent(w, resp, replayed)
+}
+
+// CaptureReservation 결제 성공 시 예약 포인트 사용 확정
+func (h *ReservationHandler) CaptureReservation(w http.ResponseWriter, r *http.Request) {
+	h.completeReservation(w, r, h.reserveUseCase.Capture, "reserved points captured")
+}
+
+// ReleaseReservation 결제 실패 시 예약 포인트 해제
+func (h *ReservationHandler) ReleaseReservation(w http.ResponseWriter, r *http.Request) {
+	h.completeReservation(w, r, h.reserveUseCase.Release, "reserved points released")
+}
+
+// completeReservation capture / release 공통 처리
+func (h *ReservationHandler) completeReservation(
+	w http.ResponseWriter,
+	r *http.Request,
+	complete func(ctx context.Context, userID, orderID int64) error,
+	message string,
+) {
+	vars := mux.Vars(r)
+	orderID, err := strconv.ParseInt(vars["order_id"], 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid order_id")
+		return
+	}
+
+	userID, err := getUserID(r)
+	if err != nil {
+		respondError(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	key := idempotencyKey(r, orderNaturalKey(orderID))
+	resp, replayed, err := executeIdempotent(r, h.idempotencyUseCase, userID, key, body, func(ctx context.Context) (int, interface{}, error) {
+		if err := complete(ctx, userID, orderID); err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, map[string]string{"message": message}, nil
+	})
+	if err != nil {
+		respondReservationError(w, err)
+		return
+	}
+
+	respondIdempotent(w, resp, replayed)
+}
+
+func respondReservationError(w http.ResponseWriter, err error) {
+	switch err {
+	case pointDomain.ErrInsufficientPoints:
+		respondError(w, http.StatusBadRequest, "insufficient points")
+	case pointDomain.ErrBelowMinUseAmount:
+		respondError(w, http.StatusBadRequest, "below minimum use amount")
+	case pointDomain.ErrInvalidUseUnit:
+		respondError(w, http.StatusBadRequest, "invalid use unit")
+	case pointDomain.ErrExceedMaxUseRate:
+		respondError(w, http.StatusBadRequest, "exceed maximum use rate")
+	case pointDomain.ErrBelowMinPayment:
+		respondError(w, http.StatusBadRequest, "below minimum payment amount")
+	case pointDomain.ErrPointNotFound:
+		respondError(w, http.StatusNotFound, "point not found")
+	case pointDomain.ErrReservationNotFound:
+		respondError(w, http.StatusNotFound, "reservation not found")
+	case pointDomain.ErrReservationExpired:
+		respondError(w, http.StatusGone, "reservation expired")
+	case pointDomain.ErrOrderAlreadyUsed, idempotency.ErrKeyReused:
+		respondError(w, http.StatusConflict, err.Error())
+	default:
+		respondError(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
+func toReservationResponse(reservation *pointDomain.Reservation) dto.ReservationResponse {
+	return dto.ReservationResponse{
+		ID:          reservation.ID,
+		UserID:      reservation.UserID,
+		OrderID:     reservation.OrderID,
+		Amount:      reservation.Amount,
+		OrderAmount: reservation.OrderAmount,
+		Status:      string(reservation.Status),
+		ExpiresAt:   reservation.ExpiresAt,
+	}
+}
@@ -0,0 +1,234 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	pointDomain "shopping-mall/internal/domain/point"
+	"shopping-mall/internal/handler/dto"
+	pointUseCase "shopping-mall/internal/usecase/point"
+
+	"github.com/gorilla/mux"
+)
+
+// WebhookHandler 포인트 이벤트 웹훅 관리 핸들러
+type WebhookHandler struct {
+	webhookUseCase *pointUseCase.WebhookUseCase
+}
+
+// NewWebhookHandler 웹훅 관리 핸들러 생성
+func NewWebhookHandler(webhookUseCase *pointUseCase.WebhookUseCase) *WebhookHandler {
+	return &WebhookHandler{
+		webhookUseCase: webhookUseCase,
+	}
+}
+
+// CreateWebhook 웹훅 구독 생성 (응답에 서명 비밀키 포함)
+func (h *WebhookHandler) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	subscription := &pointDomain.WebhookSubscription{
+		URL:         req.URL,
+		EventTypes:  toEventTypes(req.EventTypes),
+		Secret:      req.Secret,
+		Description: req.Description,
+	}
+
+	if err := h.webhookUseCase.CreateSubscription(r.Context(), subscription); err != nil {
+		respondWebhookError(w, err)
+		return
+	}
+
+	resp := toWebhookResponse(subscription)
+	resp.Secret = subscription.Secret
+	respondJSON(w, http.StatusCreated, resp)
+}
+
+// UpdateWebhook 웹훅 구독 변경 (URL, 이벤트 유형, 활성 여부, 비밀키 재발급)
+func (h *WebhookHandler) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
+	subscriptionID, ok := parseWebhookID(w, r)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	update := &pointUseCase.WebhookUpdate{
+		URL:          req.URL,
+		Description:  req.Description,
+		Active:       req.Active,
+		RotateSecret: req.RotateSecret,
+	}
+	if req.EventTypes != nil {
+		eventTypes := toEventTypes(*req.EventTypes)
+		update.EventTypes = &eventTypes
+	}
+
+	subscription, err := h.webhookUseCase.UpdateSubscription(r.Context(), subscriptionID, update)
+	if err != nil {
+		respondWebhookError(w, err)
+		return
+	}
+
+	resp := toWebhookResponse(subscription)
+	if req.RotateSecret {
+		resp.Secret = subscription.Secret
+	}
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// GetWebhook 웹훅 구독 조회
+func (h *WebhookHandler) GetWebhook(w http.ResponseWriter, r *http.Request) {
+	subscriptionID, ok := parseWebhookID(w, r)
+	if !ok {
+		return
+	}
+
+	subscription, err := h.webhookUseCase.GetSubscription(r.Context(), subscriptionID)
+	if err != nil {
+		respondWebhookError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, toWebhookResponse(subscription))
+}
+
+// ListWebhooks 웹훅 구독 목록 조회
+func (h *WebhookHandler) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	limit, offset := getPagination(r)
+
+	subscriptions, err := h.webhookUseCase.ListSubscriptions(r.Context(), limit, offset)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	responses := make([]dto.WebhookResponse, len(subscriptions))
+	for i, subscription := range subscriptions {
+		responses[i] = toWebhookResponse(subscription)
+	}
+
+	respondJSON(w, http.StatusOK, dto.WebhooksResponse{
+		Webhooks: responses,
+		Total:    len(responses),
+		Limit:    limit,
+		Offset:   offset,
+	})
+}
+
+// ListDeliveries 웹훅 전송 내역 조회 (status 로 필터)
+func (h *WebhookHandler) ListDeliveries(w http.ResponseWriter, r *http.Request) {
+	subscriptionID, ok := parseWebhookID(w, r)
+	if !ok {
+		return
+	}
+	limit, offset := getPagination(r)
+	status := pointDomain.WebhookDeliveryStatus(strings.ToUpper(r.URL.Query().Get("status")))
+
+	deliveries, err := h.webhookUseCase.ListDeliveries(r.Context(), subscriptionID, status, limit, offset)
+	if err != nil {
+		respondWebhookError(w, err)
+		return
+	}
+
+	responses := make([]dto.WebhookDeliveryResponse, len(deliveries))
+	for i, delivery := range deliveries {
+		responses[i] = toWebhookDeliveryResponse(delivery)
+	}
+
+	respondJSON(w, http.StatusOK, dto.WebhookDeliveriesResponse{
+		Deliveries: responses,
+		Total:      len(responses),
+		Limit:      limit,
+		Offset:     offset,
+	})
+}
+
+// RedeliverWebhook 웹훅 재전송 요청 (다음 전송 주기에 Worker 가 다시 전송)
+func (h *WebhookHandler) RedeliverWebhook(w http.ResponseWriter, r *http.Request) {
+	deliveryID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid delivery id")
+		return
+	}
+
+	delivery, err := h.webhookUseCase.Redeliver(r.Context(), deliveryID)
+	if err != nil {
+		respondWebhookError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusAccepted, toWebhookDeliveryResponse(delivery))
+}
+
+// parseWebhookID 경로의 웹훅 구독 ID 파싱 (실패 시 400 응답)
+func parseWebhookID(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	subscriptionID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "invalid webhook id")
+		return 0, false
+	}
+	return subscriptionID, true
+}
+
+// respondWebhookError 웹훅 관리 오류 응답
+func respondWebhookError(w http.ResponseWriter, err error) {
+	switch err {
+	case pointDomain.ErrInvalidWebhook:
+		respondError(w, http.StatusBadRequest, err.Error())
+	case pointDomain.ErrWebhookNotFound, pointDomain.ErrWebhookDeliveryNotFound:
+		respondError(w, http.StatusNotFound, err.Error())
+	default:
+		respondError(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
+func toEventTypes(values []string) []pointDomain.EventType {
+	var eventTypes []pointDomain.EventType
+	for _, value := range values {
+		eventTypes = append(eventTypes, pointDomain.EventType(strings.ToUpper(value)))
+	}
+	return eventTypes
+}
+
+func toWebhookResponse(subscription *pointDomain.WebhookSubscription) dto.WebhookResponse {
+	resp := dto.WebhookResponse{
+		ID:          subscription.ID,
+		URL:         subscription.URL,
+		Description: subscription.Description,
+		Active:      subscription.Active,
+		CreatedAt:   subscription.CreatedAt,
+		UpdatedAt:   subscription.UpdatedAt,
+	}
+	for _, eventType := range subscription.EventTypes {
+		resp.EventTypes = append(resp.EventTypes, string(eventType))
+	}
+	return resp
+}
+
+func toWebhookDeliveryResponse(delivery *pointDomain.WebhookDelivery) dto.WebhookDeliveryResponse {
+	return dto.WebhookDeliveryResponse{
+		ID:             delivery.ID,
+		SubscriptionID: delivery.SubscriptionID,
+		EventID:        delivery.EventID,
+		EventType:      string(delivery.EventType),
+		Payload:        json.RawMessage(delivery.Payload),
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		DeliveredAt:    delivery.DeliveredAt,
+		CreatedAt:      delivery.CreatedAt,
+	}
+}
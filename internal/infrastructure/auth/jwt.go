@@ -0,0 +1,314 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+)
+
+// 지원하는 JWT 서명 알고리즘
+const (
+	AlgHS256 = "HS256" // 공유 비밀키 HMAC-SHA256
+	AlgRS256 = "RS256" // RSA PKCS#1 v1.5 SHA-256 (공개키는 JWKS 파일)
+)
+
+var (
+	ErrMalformedToken       = errors.New("malformed token")
+	ErrUnsupportedAlgorithm = errors.New("unsupported token algorithm")
+	ErrUnknownKey           = errors.New("unknown token signing key")
+	ErrInvalidSignature     = errors.New("invalid token signature")
+	ErrTokenExpired         = errors.New("token expired")
+	ErrTokenNotYetValid     = errors.New("token not yet valid")
+	ErrInvalidClaims        = errors.New("invalid token claims")
+)
+
+// Config JWT 검증 설정
+type Config struct {
+	HS256Secret string        // HS256 서명 비밀키 (비어 있으면 HS256 토큰 거부)
+	JWKSFile    string        // RS256 공개키 JWKS 파일 경로 (비어 있으면 RS256 토큰 거부)
+	Issuer      string        // 허용하는 iss (비어 있으면 검사하지 않음)
+	Audience    string        // 허용하는 aud (비어 있으면 검사하지 않음)
+	Leeway      time.Duration // exp/nbf 검사 시 허용하는 시계 오차
+}
+
+// Claims 검증을 통과한 토큰의 등록 클레임
+type Claims struct {
+	Subject   string
+	Issuer    string
+	Audience  []string
+	ExpiresAt time.Time
+	NotBefore time.Time
+	IssuedAt  time.Time
+}
+
+// Verifier JWT 서명/클레임 검증기
+type Verifier struct {
+	hmacSecret []byte
+	rsaKeys    map[string]*rsa.PublicKey // kid → 공개키
+	issuer     string
+	audience   string
+	leeway     time.Duration
+}
+
+// NewVerifier JWT 검증기 생성 (JWKS 파일이 설정되어 있으면 RSA 공개키 로드)
+func NewVerifier(cfg Config) (*Verifier, error) {
+	v := &Verifier{
+		issuer:   cfg.Issuer,
+		audience: cfg.Audience,
+		leeway:   cfg.Leeway,
+	}
+	if cfg.HS256Secret != "" {
+		v.hmacSecret = []byte(cfg.HS256Secret)
+	}
+	if cfg.JWKSFile != "" {
+		keys, err := LoadJWKS(cfg.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		v.rsaKeys = keys
+	}
+	return v, nil
+}
+
+// Enabled 검증할 수 있는 서명 키가 하나라도 설정되어 있는지 확인
+func (v *Verifier) Enabled() bool {
+	return len(v.hmacSecret) > 0 || len(v.rsaKeys) > 0
+}
+
+// tokenHeader JWT 헤더
+type tokenHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// tokenClaims JWT 페이로드 (aud 는 문자열 또는 배열)
+type tokenClaims struct {
+	Sub string          `json:"sub"`
+	Iss string          `json:"iss"`
+	Aud json.RawMessage `json:"aud"`
+	Exp *float64        `json:"exp"`
+	Nbf *float64        `json:"nbf"`
+	Iat *float64        `json:"iat"`
+}
+
+// Verify 토큰 서명과 exp/nbf/iss/aud 클레임 검증
+// alg 는 설정된 키 종류로만 허용하며 none 등 다른 알고리즘은 ErrUnsupportedAlgorithm
+func (v *Verifier) Verify(token string, now time.Time) (*Claims, error) {
+	// 1. 헤더.페이로드.서명 분리
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrMalformedToken
+	}
+	var header tokenHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, ErrMalformedToken
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrMalformedToken
+	}
+
+	// 2. 서명 검증
+	signingInput := parts[0] + "." + parts[1]
+	if err := v.verifySignature(header, signingInput, signature); err != nil {
+		return nil, err
+	}
+
+	// 3. 클레임 검증
+	var payload tokenClaims
+	if err := decodeSegment(parts[1], &payload); err != nil {
+		return nil, ErrMalformedToken
+	}
+	claims, err := payload.toClaims()
+	if err != nil {
+		return nil, err
+	}
+	if err := v.validateClaims(claims, now); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// verifySignature 헤더의 alg 에 맞는 키로 서명 검증
+func (v *Verifier) verifySignature(header tokenHeader, signingInput string, signature []byte) error {
+	switch header.Alg {
+	case AlgHS256:
+		if len(v.hmacSecret) == 0 {
+			return ErrUnsupportedAlgorithm
+		}
+		mac := hmac.New(sha256.New, v.hmacSecret)
+		mac.Write([]byte(signingInput))
+		if !hmac.Equal(mac.Sum(nil), signature) {
+			return ErrInvalidSignature
+		}
+		return nil
+	case AlgRS256:
+		if len(v.rsaKeys) == 0 {
+			return ErrUnsupportedAlgorithm
+		}
+		key, err := v.rsaKey(header.Kid)
+		if err != nil {
+			return err
+		}
+		digest := sha256.Sum256([]byte(signingInput))
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+			return ErrInvalidSignature
+		}
+		return nil
+	default:
+		return ErrUnsupportedAlgorithm
+	}
+}
+
+// rsaKey kid 에 해당하는 공개키 조회 (kid 가 없으면 키가 하나일 때만 허용)
+func (v *Verifier) rsaKey(kid string) (*rsa.PublicKey, error) {
+	if kid == "" {
+		if len(v.rsaKeys) == 1 {
+			for _, key := range v.rsaKeys {
+				return key, nil
+			}
+		}
+		return nil, ErrUnknownKey
+	}
+	key, ok := v.rsaKeys[kid]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	return key, nil
+}
+
+// validateClaims sub 필수, exp 필수, nbf/iss/aud 는 설정에 따라 검사
+func (v *Verifier) validateClaims(claims *Claims, now time.Time) error {
+	if claims.Subject == "" || claims.ExpiresAt.IsZero() {
+		return ErrInvalidClaims
+	}
+	if !now.Before(claims.ExpiresAt.Add(v.leeway)) {
+		return ErrTokenExpired
+	}
+	if !claims.NotBefore.IsZero() && now.Add(v.leeway).Before(claims.NotBefore) {
+		return ErrTokenNotYetValid
+	}
+	if v.issuer != "" && claims.Issuer != v.issuer {
+		return ErrInvalidClaims
+	}
+	if v.audience != "" && !contains(claims.Audience, v.audience) {
+		return ErrInvalidClaims
+	}
+	return nil
+}
+
+// toClaims 페이로드를 등록 클레임으로 변환
+func (c *tokenClaims) toClaims() (*Claims, error) {
+	claims := &Claims{
+		Subject:   c.Sub,
+		Issuer:    c.Iss,
+		ExpiresAt: unixTime(c.Exp),
+		NotBefore: unixTime(c.Nbf),
+		IssuedAt:  unixTime(c.Iat),
+	}
+	if len(c.Aud) > 0 {
+		var single string
+		if err := json.Unmarshal(c.Aud, &single); err == nil {
+			claims.Audience = []string{single}
+		} else if err := json.Unmarshal(c.Aud, &claims.Audience); err != nil {
+			return nil, ErrInvalidClaims
+		}
+	}
+	return claims, nil
+}
+
+// jwks JWKS 문서
+type jwks struct {
+	Keys []jwk `json:"keys"`
+}
+
+// jwk JWKS 의 개별 키 (RSA 공개키만 사용)
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// LoadJWKS JWKS 파일에서 RS256 서명용 RSA 공개키 로드 (kid → 공개키)
+// RSA 가 아니거나 서명용이 아닌 키는 건너뛰고, 사용할 키가 없으면 오류
+func LoadJWKS(path string) (map[string]*rsa.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read jwks file: %w", err)
+	}
+	var doc jwks
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse jwks file: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey)
+	for _, k := range doc.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") || (k.Alg != "" && k.Alg != AlgRS256) {
+			continue
+		}
+		key, err := k.rsaPublicKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid jwks key %q: %w", k.Kid, err)
+		}
+		if _, exists := keys[k.Kid]; exists {
+			return nil, fmt.Errorf("duplicate jwks key id %q", k.Kid)
+		}
+		keys[k.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("jwks file has no RS256 signing keys: %s", path)
+	}
+	return keys, nil
+}
+
+// rsaPublicKey base64url 로 인코딩된 modulus/exponent 를 RSA 공개키로 변환
+func (k *jwk) rsaPublicKey() (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil || len(n) == 0 {
+		return nil, errors.New("invalid modulus")
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil || len(e) == 0 || len(e) > 4 {
+		return nil, errors.New("invalid exponent")
+	}
+	exponent := new(big.Int).SetBytes(e)
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+}
+
+// decodeSegment base64url 세그먼트를 JSON 으로 해석
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// unixTime NumericDate 를 시각으로 변환 (없으면 zero value)
+func unixTime(seconds *float64) time.Time {
+	if seconds == nil {
+		return time.Time{}
+	}
+	return time.Unix(int64(*seconds), 0)
+}
+
+func contains(values []string, target string) bool {
+	for _, value := range values {
+		if value == target {
+			return true
+		}
+	}
+	return false
+}
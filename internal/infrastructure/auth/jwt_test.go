@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const testSecret = "hs256-test-secret-0123456789abcdef"
+
+func TestVerifierVerify(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	jwksFile := writeJWKS(t, "key-1", &key.PublicKey)
+	publicKeyDER, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("MarshalPKIXPublicKey() error = %v", err)
+	}
+
+	valid := map[string]interface{}{"sub": "42", "exp": now.Add(time.Hour).Unix()}
+	expired := map[string]interface{}{"sub": "42", "exp": now.Add(-time.Minute).Unix()}
+	noExp := map[string]interface{}{"sub": "42"}
+
+	tests := []struct {
+		name    string
+		cfg     Config
+		token   string
+		wantErr error
+	}{
+		{"hs256 valid", Config{HS256Secret: testSecret}, signHS256(t, testSecret, "", valid), nil},
+		{"rs256 valid", Config{JWKSFile: jwksFile}, signRS256(t, key, "key-1", valid), nil},
+		{"rs256 without kid single key", Config{JWKSFile: jwksFile}, signRS256(t, key, "", valid), nil},
+		{"alg none", Config{HS256Secret: testSecret, JWKSFile: jwksFile}, unsigned(t, valid), ErrUnsupportedAlgorithm},
+		{"hs256 signed with rsa public key", Config{JWKSFile: jwksFile}, signHS256(t, string(publicKeyDER), "key-1", valid), ErrUnsupportedAlgorithm},
+		{"hs256 signed with rsa public key both enabled", Config{HS256Secret: testSecret, JWKSFile: jwksFile}, signHS256(t, string(publicKeyDER), "key-1", valid), ErrInvalidSignature},
+		{"hs256 wrong secret", Config{HS256Secret: testSecret}, signHS256(t, "other-secret", "", valid), ErrInvalidSignature},
+		{"expired", Config{HS256Secret: testSecret}, signHS256(t, testSecret, "", expired), ErrTokenExpired},
+		{"missing exp", Config{HS256Secret: testSecret}, signHS256(t, testSecret, "", noExp), ErrInvalidClaims},
+		{"unknown kid", Config{JWKSFile: jwksFile}, signRS256(t, key, "key-2", valid), ErrUnknownKey},
+		{"malformed", Config{HS256Secret: testSecret}, "not-a-token", ErrMalformedToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verifier, err := NewVerifier(tt.cfg)
+			if err != nil {
+				t.Fatalf("NewVerifier() error = %v", err)
+			}
+
+			claims, err := verifier.Verify(tt.token, now)
+			if err != tt.wantErr {
+				t.Fatalf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && claims.Subject != "42" {
+				t.Errorf("subject = %q, want %q", claims.Subject, "42")
+			}
+		})
+	}
+}
+
+func TestVerifierLeeway(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	token := signHS256(t, testSecret, "", map[string]interface{}{"sub": "42", "exp": now.Add(-10 * time.Second).Unix()})
+
+	verifier, err := NewVerifier(Config{HS256Secret: testSecret, Leeway: 30 * time.Second})
+	if err != nil {
+		t.Fatalf("NewVerifier() error = %v", err)
+	}
+	if _, err := verifier.Verify(token, now); err != nil {
+		t.Errorf("Verify() within leeway error = %v", err)
+	}
+}
+
+func signHS256(t *testing.T, secret, kid string, claims map[string]interface{}) string {
+	t.Helper()
+	signingInput := encodeSegments(t, AlgHS256, kid, claims)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(signingInput))
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]interface{}) string {
+	t.Helper()
+	signingInput := encodeSegments(t, AlgRS256, kid, claims)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatalf("SignPKCS1v15() error = %v", err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func unsigned(t *testing.T, claims map[string]interface{}) string {
+	t.Helper()
+	return encodeSegments(t, "none", "", claims) + "."
+}
+
+func encodeSegments(t *testing.T, alg, kid string, claims map[string]interface{}) string {
+	t.Helper()
+	header, err := json.Marshal(tokenHeader{Alg: alg, Kid: kid})
+	if err != nil {
+		t.Fatalf("marshal header: %v", err)
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatalf("marshal claims: %v", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+}
+
+func writeJWKS(t *testing.T, kid string, key *rsa.PublicKey) string {
+	t.Helper()
+	doc := jwks{Keys: []jwk{{
+		Kty: "RSA",
+		Kid: kid,
+		Use: "sig",
+		Alg: AlgRS256,
+		N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}}}
+	data, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatalf("marshal jwks: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("write jwks: %v", err)
+	}
+	return path
+}
@@ -0,0 +1,148 @@
+package auth
+
+import (
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// TokenSet 이름별 정적 토큰 목록 (서비스 API 키, 관리자 운영자 토큰)
+type TokenSet struct {
+	tokens map[string]string // 이름 → 토큰
+}
+
+// NewTokenSet 토큰 목록 생성
+func NewTokenSet(tokens map[string]string) *TokenSet {
+	return &TokenSet{tokens: tokens}
+}
+
+// Len 등록된 토큰 수
+func (s *TokenSet) Len() int {
+	return len(s.tokens)
+}
+
+// Authenticate 토큰과 일치하는 이름 반환 (모든 토큰을 상수 시간으로 비교)
+func (s *TokenSet) Authenticate(token string) (string, bool) {
+	if token == "" {
+		return "", false
+	}
+	name, matched := "", false
+	for candidate, expected := range s.tokens {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(expected)) == 1 {
+			name, matched = candidate, true
+		}
+	}
+	return name, matched
+}
+
+// ServiceAuthenticator 서비스 간 호출 인증 (API 키 또는 mTLS 클라이언트 인증서)
+type ServiceAuthenticator struct {
+	apiKeys    *TokenSet           // 서비스명 → API 키
+	identities map[string]struct{} // 허용하는 클라이언트 인증서 CN/SAN
+}
+
+// NewServiceAuthenticator 서비스 인증기 생성 (둘 다 비어 있으면 모든 서비스 요청 거부)
+func NewServiceAuthenticator(apiKeys map[string]string, identities []string) *ServiceAuthenticator {
+	allowed := make(map[string]struct{}, len(identities))
+	for _, identity := range identities {
+		allowed[identity] = struct{}{}
+	}
+	return &ServiceAuthenticator{
+		apiKeys:    NewTokenSet(apiKeys),
+		identities: allowed,
+	}
+}
+
+// Enabled 인증 수단이 하나라도 설정되어 있는지 확인
+func (a *ServiceAuthenticator) Enabled() bool {
+	return a.apiKeys.Len() > 0 || len(a.identities) > 0
+}
+
+// AuthenticateAPIKey API 키로 서비스 인증 (일치하는 서비스명 반환)
+func (a *ServiceAuthenticator) AuthenticateAPIKey(key string) (string, bool) {
+	return a.apiKeys.Authenticate(key)
+}
+
+// AuthenticateCertificate 검증된 클라이언트 인증서로 서비스 인증
+// 인증서의 CN, DNS/URI SAN 중 허용 목록에 있는 값을 서비스명으로 반환
+func (a *ServiceAuthenticator) AuthenticateCertificate(state *tls.ConnectionState) (string, bool) {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+	leaf := state.VerifiedChains[0][0]
+
+	candidates := []string{leaf.Subject.CommonName}
+	candidates = append(candidates, leaf.DNSNames...)
+	for _, uri := range leaf.URIs {
+		candidates = append(candidates, uri.String())
+	}
+	for _, candidate := range candidates {
+		if _, ok := a.identities[candidate]; ok && candidate != "" {
+			return candidate, true
+		}
+	}
+	return "", false
+}
+
+// ParseTokens "이름:토큰" 쉼표 구분 목록 해석 (서비스 API 키, 관리자 운영자 토큰)
+// 같은 토큰을 여러 이름에 쓰면 누가 인증했는지 알 수 없으므로 오류
+func ParseTokens(value string) (map[string]string, error) {
+	keys := make(map[string]string)
+	seen := make(map[string]struct{})
+	for _, entry := range splitList(value) {
+		name, key, ok := strings.Cut(entry, ":")
+		name, key = strings.TrimSpace(name), strings.TrimSpace(key)
+		if !ok || name == "" || key == "" {
+			return nil, fmt.Errorf("invalid token entry: %q", name)
+		}
+		if _, exists := keys[name]; exists {
+			return nil, fmt.Errorf("duplicate token name: %q", name)
+		}
+		if _, exists := seen[key]; exists {
+			return nil, fmt.Errorf("token of %q is shared with another name", name)
+		}
+		seen[key] = struct{}{}
+		keys[name] = key
+	}
+	return keys, nil
+}
+
+// ParseIdentities 쉼표 구분 mTLS 클라이언트 식별자 목록 해석
+func ParseIdentities(value string) []string {
+	return splitList(value)
+}
+
+// NewServerTLSConfig mTLS 서버 설정 (clientCAFile 로 서명된 클라이언트 인증서를 검증)
+// 사용자 요청은 클라이언트 인증서 없이도 접속할 수 있도록 인증서는 선택 사항
+func NewServerTLSConfig(clientCAFile string) (*tls.Config, error) {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if clientCAFile == "" {
+		return cfg, nil
+	}
+
+	pem, err := os.ReadFile(clientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client ca file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates in client ca file: %s", clientCAFile)
+	}
+	cfg.ClientCAs = pool
+	cfg.ClientAuth = tls.VerifyClientCertIfGiven
+	return cfg, nil
+}
+
+// splitList 쉼표 구분 목록 (빈 항목 제외)
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+)
+
+func TestServiceAuthenticatorAPIKey(t *testing.T) {
+	authenticator := NewServiceAuthenticator(map[string]string{"order": "order-key", "payment": "payment-key"}, nil)
+
+	tests := []struct {
+		name        string
+		key         string
+		wantService string
+		wantOK      bool
+	}{
+		{"valid key", "payment-key", "payment", true},
+		{"bad key", "wrong-key", "", false},
+		{"key prefix", "order", "", false},
+		{"empty key", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, ok := authenticator.AuthenticateAPIKey(tt.key)
+			if ok != tt.wantOK || service != tt.wantService {
+				t.Errorf("AuthenticateAPIKey() = (%q, %v), want (%q, %v)", service, ok, tt.wantService, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestServiceAuthenticatorCertificate(t *testing.T) {
+	authenticator := NewServiceAuthenticator(nil, []string{"order-service"})
+	verified := func(cert *x509.Certificate) *tls.ConnectionState {
+		return &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+	}
+
+	tests := []struct {
+		name        string
+		state       *tls.ConnectionState
+		wantService string
+		wantOK      bool
+	}{
+		{"common name", verified(&x509.Certificate{Subject: pkix.Name{CommonName: "order-service"}}), "order-service", true},
+		{"dns san", verified(&x509.Certificate{DNSNames: []string{"order-service"}}), "order-service", true},
+		{"unknown identity", verified(&x509.Certificate{Subject: pkix.Name{CommonName: "other-service"}}), "", false},
+		{"no tls", nil, "", false},
+		{"missing client cert", &tls.ConnectionState{}, "", false},
+		{"unverified client cert", &tls.ConnectionState{PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "order-service"}}}}, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, ok := authenticator.AuthenticateCertificate(tt.state)
+			if ok != tt.wantOK || service != tt.wantService {
+				t.Errorf("AuthenticateCertificate() = (%q, %v), want (%q, %v)", service, ok, tt.wantService, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestParseTokens(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    int
+		wantErr bool
+	}{
+		{"empty", "", 0, false},
+		{"two tokens", "alice:token-a, bob:token-b", 2, false},
+		{"missing token", "alice:", 0, true},
+		{"missing separator", "alice", 0, true},
+		{"duplicate name", "alice:token-a,alice:token-b", 0, true},
+		{"shared token", "alice:token-a,bob:token-a", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens, err := ParseTokens(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseTokens() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(tokens) != tt.want {
+				t.Errorf("ParseTokens() = %v, want %d tokens", tokens, tt.want)
+			}
+		})
+	}
+}
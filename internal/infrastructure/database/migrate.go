@@ -1,74 +1,532 @@
 package database
 
 import (
+	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 )
 
-// Migrate 마이그레이션 실행
-func Migrate(db *sql.DB, migrationsDir string) error {
-	// 마이그레이션 파일 읽기
-	files, err := os.ReadDir(migrationsDir)
+var (
+	// ErrPendingMigrations 적용되지 않은 마이그레이션이 있음
+	ErrPendingMigrations = errors.New("pending migrations")
+
+	// ErrChecksumMismatch 이미 적용된 마이그레이션 파일이 수정됨
+	ErrChecksumMismatch = errors.New("migration checksum mismatch")
+
+	// ErrMissingDownMigration 되돌릴 down 파일이 없음
+	ErrMissingDownMigration = errors.New("missing down migration")
+)
+
+// migrationLockName 동시에 여러 프로세스가 마이그레이션을 실행하지 않도록 하는 MySQL named lock
+const migrationLockName = "schema_migrations"
+
+// migrationLockTimeout named lock 대기 시간 (초)
+const migrationLockTimeout = 60
+
+// migrationFilePattern {버전}_{이름}.up.sql / {버전}_{이름}.down.sql
+var migrationFilePattern = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+// Migration 버전별 마이그레이션 (up/down 파일 쌍)
+type Migration struct {
+	Version  int64
+	Name     string
+	UpSQL    string
+	DownSQL  string
+	Checksum string // up 파일 SHA-256
+}
+
+// MigrationStatus 마이그레이션 적용 상태
+type MigrationStatus struct {
+	Migration
+	Applied          bool
+	AppliedAt        *time.Time
+	ChecksumMismatch bool // 적용 이후 up 파일이 수정됨
+	Missing          bool // 적용 기록은 있으나 파일이 없음
+}
+
+// Migrator 버전 기반 마이그레이션 실행기 (schema_migrations 테이블로 적용 내역 관리)
+type Migrator struct {
+	db     *sql.DB
+	dir    string
+	dryRun bool
+	out    io.Writer
+}
+
+// NewMigrator 마이그레이션 실행기 생성
+func NewMigrator(db *sql.DB, migrationsDir string) *Migrator {
+	return &Migrator{
+		db:  db,
+		dir: migrationsDir,
+		out: os.Stdout,
+	}
+}
+
+// WithDryRun 실제로 실행하지 않고 실행할 SQL 만 출력
+func (m *Migrator) WithDryRun(dryRun bool) *Migrator {
+	m.dryRun = dryRun
+	return m
+}
+
+// WithOutput 진행 상황 출력 대상 설정
+func (m *Migrator) WithOutput(out io.Writer) *Migrator {
+	m.out = out
+	return m
+}
+
+// Load 마이그레이션 파일 로드 (버전 오름차순)
+func (m *Migrator) Load() ([]*Migration, error) {
+	files, err := os.ReadDir(m.dir)
 	if err != nil {
-		return fmt.Errorf("failed to read migrations directory: %w", err)
+		return nil, fmt.Errorf("failed to read migrations directory: %w", err)
 	}
 
-	// 파일명 순서대로 정렬하여 실행
+	byVersion := make(map[int64]*Migration)
 	for _, file := range files {
-		if !strings.HasSuffix(file.Name(), ".sql") {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".sql") {
 			continue
 		}
 
-		filePath := filepath.Join(migrationsDir, file.Name())
-		sqlBytes, err := os.ReadFile(filePath)
+		matches := migrationFilePattern.FindStringSubmatch(file.Name())
+		if matches == nil {
+			return nil, fmt.Errorf("invalid migration file name %s (expected {version}_{name}.up.sql or .down.sql)", file.Name())
+		}
+
+		version, err := strconv.ParseInt(matches[1], 10, 64)
 		if err != nil {
-			return fmt.Errorf("failed to read migration file %s: %w", file.Name(), err)
+			return nil, fmt.Errorf("invalid migration version in %s: %w", file.Name(), err)
+		}
+
+		content, err := os.ReadFile(filepath.Join(m.dir, file.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration file %s: %w", file.Name(), err)
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: matches[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != matches[2] {
+			return nil, fmt.Errorf("migration version %d has conflicting names: %s, %s", version, migration.Name, matches[2])
+		}
+
+		if matches[3] == "up" {
+			sum := sha256.Sum256(content)
+			migration.UpSQL = string(content)
+			migration.Checksum = hex.EncodeToString(sum[:])
+		} else {
+			migration.DownSQL = string(content)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Checksum == "" {
+			return nil, fmt.Errorf("migration %03d_%s has no up file", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Status 전체 마이그레이션 적용 상태 조회
+// dry-run 이면 schema_migrations 를 만들지 않으며, 테이블이 없으면 모두 적용되지 않은 것으로 조회
+func (m *Migrator) Status(ctx context.Context) ([]*MigrationStatus, error) {
+	if !m.dryRun {
+		if err := m.ensureVersionTable(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	migrations, err := m.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	applied, err := m.appliedVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []*MigrationStatus
+	for _, migration := range migrations {
+		status := &MigrationStatus{Migration: *migration}
+		if record, ok := applied[migration.Version]; ok {
+			appliedAt := record.appliedAt
+			status.Applied = true
+			status.AppliedAt = &appliedAt
+			status.ChecksumMismatch = record.checksum != migration.Checksum
+			delete(applied, migration.Version)
+		}
+		statuses = append(statuses, status)
+	}
+
+	// 적용 기록은 있으나 파일이 삭제된 마이그레이션
+	for version, record := range applied {
+		appliedAt := record.appliedAt
+		statuses = append(statuses, &MigrationStatus{
+			Migration: Migration{Version: version, Name: record.name, Checksum: record.checksum},
+			Applied:   true,
+			AppliedAt: &appliedAt,
+			Missing:   true,
+		})
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Version < statuses[j].Version
+	})
+
+	return statuses, nil
+}
+
+// Pending 적용되지 않은 마이그레이션 조회
+// 이미 적용된 파일이 수정되었으면 ErrChecksumMismatch 반환
+func (m *Migrator) Pending(ctx context.Context) ([]*Migration, error) {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []*Migration
+	for _, status := range statuses {
+		if status.ChecksumMismatch {
+			return nil, fmt.Errorf("%w: %03d_%s", ErrChecksumMismatch, status.Version, status.Name)
+		}
+		if !status.Applied {
+			migration := status.Migration
+			pending = append(pending, &migration)
+		}
+	}
+	return pending, nil
+}
+
+// Up 적용되지 않은 마이그레이션을 버전 순서대로 모두 적용하고 적용한 개수 반환
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	conn, unlock, err := m.lock(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, migration := range pending {
+		if err := m.apply(ctx, conn, migration); err != nil {
+			return i, err
+		}
+	}
+
+	if len(pending) == 0 {
+		fmt.Fprintln(m.out, "✓ No pending migrations")
+	}
+	return len(pending), nil
+}
+
+// Down 최근 적용된 마이그레이션부터 n 개를 되돌리고 되돌린 개수 반환
+func (m *Migrator) Down(ctx context.Context, n int) (int, error) {
+	conn, unlock, err := m.lock(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var applied []*MigrationStatus
+	for _, status := range statuses {
+		if status.Applied {
+			applied = append(applied, status)
+		}
+	}
+
+	reverted := 0
+	for i := len(applied) - 1; i >= 0 && reverted < n; i-- {
+		status := applied[i]
+		if status.Missing || status.DownSQL == "" {
+			return reverted, fmt.Errorf("%w: %03d_%s", ErrMissingDownMigration, status.Version, status.Name)
+		}
+		if err := m.revert(ctx, conn, &status.Migration); err != nil {
+			return reverted, err
+		}
+		reverted++
+	}
+
+	if reverted == 0 {
+		fmt.Fprintln(m.out, "✓ No applied migrations to revert")
+	}
+	return reverted, nil
+}
+
+// Redo 마지막으로 적용된 마이그레이션을 되돌린 후 다시 적용
+func (m *Migrator) Redo(ctx context.Context) error {
+	conn, unlock, err := m.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := len(statuses) - 1; i >= 0; i-- {
+		status := statuses[i]
+		if !status.Applied {
+			continue
+		}
+		if status.Missing || status.DownSQL == "" {
+			return fmt.Errorf("%w: %03d_%s", ErrMissingDownMigration, status.Version, status.Name)
+		}
+		if status.ChecksumMismatch {
+			return fmt.Errorf("%w: %03d_%s", ErrChecksumMismatch, status.Version, status.Name)
 		}
 
-		// SQL 파일 전체를 하나의 트랜잭션으로 실행
-		sqlContent := string(sqlBytes)
+		migration := status.Migration
+		if err := m.revert(ctx, conn, &migration); err != nil {
+			return err
+		}
+		return m.apply(ctx, conn, &migration)
+	}
 
-		// 주석 제거 (간단한 처리)
-		lines := strings.Split(sqlContent, "\n")
-		var cleanLines []string
-		for _, line := range lines {
-			trimmed := strings.TrimSpace(line)
-			// 주석 라인과 빈 라인 제외
-			if trimmed != "" && !strings.HasPrefix(trimmed, "--") {
-				cleanLines = append(cleanLines, line)
-			}
+	fmt.Fprintln(m.out, "✓ No applied migrations to redo")
+	return nil
+}
+
+// Baseline version 이하의 마이그레이션을 실행하지 않고 적용된 것으로 기록
+// schema_migrations 도입 이전에 스키마가 이미 만들어진 데이터베이스에서 한 번 사용
+func (m *Migrator) Baseline(ctx context.Context, version int64) (int, error) {
+	conn, unlock, err := m.lock(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	marked := 0
+	for _, migration := range pending {
+		if migration.Version > version {
+			break
+		}
+		fmt.Fprintf(m.out, "✓ Baseline %03d_%s\n", migration.Version, migration.Name)
+		if m.dryRun {
+			marked++
+			continue
+		}
+		if err := m.recordApplied(ctx, conn, migration); err != nil {
+			return marked, err
 		}
-		sqlContent = strings.Join(cleanLines, "\n")
+		marked++
+	}
+	return marked, nil
+}
+
+// apply up 마이그레이션 실행 후 적용 내역 기록
+// MySQL DDL 은 암묵적으로 커밋되므로 파일 단위 트랜잭션으로 묶지 않음 (실패 시 수동 확인 필요)
+func (m *Migrator) apply(ctx context.Context, conn *sql.Conn, migration *Migration) error {
+	if err := m.execute(ctx, conn, migration, migration.UpSQL, "up"); err != nil {
+		return err
+	}
+	if m.dryRun {
+		return nil
+	}
+	if err := m.recordApplied(ctx, conn, migration); err != nil {
+		return err
+	}
 
-		// 세미콜론으로 분리하되, 빈 문장은 제외
-		statements := strings.Split(sqlContent, ";")
+	fmt.Fprintf(m.out, "✓ Migration %03d_%s applied\n", migration.Version, migration.Name)
+	return nil
+}
+
+// revert down 마이그레이션 실행 후 적용 내역 삭제
+func (m *Migrator) revert(ctx context.Context, conn *sql.Conn, migration *Migration) error {
+	if err := m.execute(ctx, conn, migration, migration.DownSQL, "down"); err != nil {
+		return err
+	}
+	if m.dryRun {
+		return nil
+	}
+	if _, err := conn.ExecContext(ctx, "DELETE FROM schema_migrations WHERE version = ?", migration.Version); err != nil {
+		return fmt.Errorf("failed to delete migration record %03d_%s: %w", migration.Version, migration.Name, err)
+	}
+
+	fmt.Fprintf(m.out, "✓ Migration %03d_%s reverted\n", migration.Version, migration.Name)
+	return nil
+}
+
+// execute 마이그레이션 SQL 문장 실행 (dry-run 이면 출력만)
+func (m *Migrator) execute(ctx context.Context, conn *sql.Conn, migration *Migration, content, direction string) error {
+	statements := splitStatements(content)
+	if m.dryRun {
+		fmt.Fprintf(m.out, "-- [dry-run] %03d_%s (%s)\n", migration.Version, migration.Name, direction)
 		for _, stmt := range statements {
-			stmt = strings.TrimSpace(stmt)
-			if stmt == "" {
-				continue
-			}
+			fmt.Fprintf(m.out, "%s;\n", stmt)
+		}
+		return nil
+	}
 
-			// SQL 실행
-			if _, err := db.Exec(stmt); err != nil {
-				// 이미 존재하는 테이블은 무시 (IF NOT EXISTS)
-				if strings.Contains(err.Error(), "already exists") ||
-					strings.Contains(err.Error(), "Duplicate") {
-					fmt.Printf("⚠ Table already exists in %s, skipping\n", file.Name())
-					continue
-				}
-				return fmt.Errorf("failed to execute migration %s: %w\nSQL: %s", file.Name(), err, stmt)
-			}
+	for _, stmt := range statements {
+		if _, err := conn.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("failed to execute migration %03d_%s (%s): %w\nSQL: %s", migration.Version, migration.Name, direction, err, stmt)
 		}
+	}
+	return nil
+}
 
-		fmt.Printf("✓ Migration %s executed successfully\n", file.Name())
+// recordApplied 적용 내역 기록
+func (m *Migrator) recordApplied(ctx context.Context, conn *sql.Conn, migration *Migration) error {
+	query := `
+		INSERT INTO schema_migrations (version, name, checksum, applied_at)
+		VALUES (?, ?, ?, ?)
+	`
+	if _, err := conn.ExecContext(ctx, query, migration.Version, migration.Name, migration.Checksum, time.Now()); err != nil {
+		return fmt.Errorf("failed to record migration %03d_%s: %w", migration.Version, migration.Name, err)
 	}
+	return nil
+}
 
+// ensureVersionTable schema_migrations 테이블 생성
+func (m *Migrator) ensureVersionTable(ctx context.Context) error {
+	query := `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version BIGINT PRIMARY KEY,
+			name VARCHAR(255) NOT NULL,
+			checksum CHAR(64) NOT NULL,
+			applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='적용된 마이그레이션'
+	`
+	if _, err := m.db.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
 	return nil
 }
 
+// appliedMigration schema_migrations 행
+type appliedMigration struct {
+	name      string
+	checksum  string
+	appliedAt time.Time
+}
+
+// appliedVersions 적용된 마이그레이션 조회
+func (m *Migrator) appliedVersions(ctx context.Context) (map[int64]appliedMigration, error) {
+	applied := make(map[int64]appliedMigration)
+	if m.dryRun {
+		exists, err := m.versionTableExists(ctx)
+		if err != nil || !exists {
+			return applied, err
+		}
+	}
+
+	rows, err := m.db.QueryContext(ctx, "SELECT version, name, checksum, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var version int64
+		var record appliedMigration
+		if err := rows.Scan(&version, &record.name, &record.checksum, &record.appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = record
+	}
+	return applied, rows.Err()
+}
+
+// versionTableExists schema_migrations 테이블 존재 여부 확인
+func (m *Migrator) versionTableExists(ctx context.Context) (bool, error) {
+	query := `
+		SELECT COUNT(*) FROM information_schema.tables
+		WHERE table_schema = DATABASE() AND table_name = 'schema_migrations'
+	`
+
+	var count int
+	if err := m.db.QueryRowContext(ctx, query).Scan(&count); err != nil {
+		return false, fmt.Errorf("failed to check schema_migrations table: %w", err)
+	}
+	return count > 0, nil
+}
+
+// lock 마이그레이션 named lock 획득 (락을 잡은 커넥션에서 마이그레이션 실행)
+// dry-run 이면 변경하지 않으므로 락을 잡지 않고 schema_migrations 도 만들지 않음 (커넥션은 nil)
+func (m *Migrator) lock(ctx context.Context) (*sql.Conn, func(), error) {
+	if m.dryRun {
+		return nil, func() {}, nil
+	}
+	if err := m.ensureVersionTable(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var acquired sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?)", migrationLockName, migrationLockTimeout).Scan(&acquired); err != nil {
+		conn.Close()
+		return nil, nil, fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	if !acquired.Valid || acquired.Int64 != 1 {
+		conn.Close()
+		return nil, nil, errors.New("failed to acquire migration lock: another migration is running")
+	}
+
+	unlock := func() {
+		_, _ = conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", migrationLockName)
+		conn.Close()
+	}
+	return conn, unlock, nil
+}
+
+// splitStatements 주석과 빈 줄을 제거하고 세미콜론 단위로 SQL 문장 분리
+func splitStatements(content string) []string {
+	lines := strings.Split(content, "\n")
+	var cleanLines []string
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		// 주석 라인과 빈 라인 제외
+		if trimmed != "" && !strings.HasPrefix(trimmed, "--") {
+			cleanLines = append(cleanLines, line)
+		}
+	}
+
+	var statements []string
+	for _, stmt := range strings.Split(strings.Join(cleanLines, "\n"), ";") {
+		stmt = strings.TrimSpace(stmt)
+		if stmt != "" {
+			statements = append(statements, stmt)
+		}
+	}
+	return statements
+}
+
 // EnsureDatabase 데이터베이스가 없으면 생성
 func EnsureDatabase(cfg Config) error {
 	// 데이터베이스 이름을 제외한 DSN 생성
@@ -94,8 +552,9 @@ func EnsureDatabase(cfg Config) error {
 	return nil
 }
 
-// InitDatabase 데이터베이스 초기화 (데이터베이스 생성 + 마이그레이션)
-func InitDatabase(cfg Config, migrationsDir string) error {
+// InitDatabase 데이터베이스 초기화 (데이터베이스 생성 + 마이그레이션 확인)
+// autoMigrate 이면 적용되지 않은 마이그레이션을 적용하고, 아니면 ErrPendingMigrations 반환
+func InitDatabase(cfg Config, migrationsDir string, autoMigrate bool) error {
 	// 1. 데이터베이스 생성
 	if err := EnsureDatabase(cfg); err != nil {
 		return fmt.Errorf("failed to ensure database: %w", err)
@@ -108,13 +567,33 @@ func InitDatabase(cfg Config, migrationsDir string) error {
 	}
 	defer db.Close()
 
-	// 3. 마이그레이션 실행
-	if migrationsDir != "" {
-		if _, err := os.Stat(migrationsDir); err == nil {
-			if err := Migrate(db, migrationsDir); err != nil {
-				return fmt.Errorf("failed to run migrations: %w", err)
-			}
+	// 3. 마이그레이션 확인/적용 (디렉토리가 없으면 적용 여부를 확인할 수 없으므로 시작 거부)
+	if migrationsDir == "" {
+		return errors.New("migrations directory is not set")
+	}
+	if _, err := os.Stat(migrationsDir); err != nil {
+		return fmt.Errorf("failed to read migrations directory: %w", err)
+	}
+
+	ctx := context.Background()
+	migrator := NewMigrator(db, migrationsDir)
+	if autoMigrate {
+		if _, err := migrator.Up(ctx); err != nil {
+			return fmt.Errorf("failed to run migrations: %w", err)
+		}
+		return nil
+	}
+
+	pending, err := migrator.Pending(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check migrations: %w", err)
+	}
+	if len(pending) > 0 {
+		names := make([]string, len(pending))
+		for i, migration := range pending {
+			names[i] = fmt.Sprintf("%03d_%s", migration.Version, migration.Name)
 		}
+		return fmt.Errorf("%w: %s (run `go run ./cmd/migrate up` or set DB_AUTO_MIGRATE=true)", ErrPendingMigrations, strings.Join(names, ", "))
 	}
 
 	return nil
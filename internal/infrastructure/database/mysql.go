@@ -44,9 +44,10 @@ func NewMySQL(cfg Config) (*sql.DB, error) {
 }
 
 // NewMySQLWithInit MySQL 연결 생성 및 초기화
-func NewMySQLWithInit(cfg Config, migrationsDir string) (*sql.DB, error) {
+// 적용되지 않은 마이그레이션이 있으면 autoMigrate 일 때만 적용하고, 아니면 에러 반환
+func NewMySQLWithInit(cfg Config, migrationsDir string, autoMigrate bool) (*sql.DB, error) {
 	// 데이터베이스 초기화
-	if err := InitDatabase(cfg, migrationsDir); err != nil {
+	if err := InitDatabase(cfg, migrationsDir, autoMigrate); err != nil {
 		return nil, fmt.Errorf("failed to initialize database: %w", err)
 	}
 
@@ -0,0 +1,57 @@
+package publisher
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"shopping-mall/internal/domain/point"
+)
+
+// HTTPPublisher 이벤트 페이로드를 HTTP POST 로 전달하는 발행자
+// 2xx 이외의 응답과 네트워크 오류는 실패로 처리해 재시도
+type HTTPPublisher struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPPublisher HTTP 발행자 생성
+func NewHTTPPublisher(endpoint string, timeout time.Duration) (*HTTPPublisher, error) {
+	parsed, err := url.Parse(endpoint)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid event publisher url: %q", endpoint)
+	}
+	return &HTTPPublisher{
+		url:    endpoint,
+		client: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// Publish 이벤트 1건 전송 (구독자 중복 제거용 X-Event-ID 헤더 포함)
+func (p *HTTPPublisher) Publish(ctx context.Context, event *point.OutboxEvent) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(event.Payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Event-ID", event.EventID)
+	req.Header.Set("X-Event-Type", string(event.EventType))
+	req.Header.Set("X-User-ID", strconv.FormatInt(event.UserID, 10))
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("event publisher received status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package publisher
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"shopping-mall/internal/domain/point"
+
+	"go.uber.org/zap"
+)
+
+// LogPublisher 이벤트를 애플리케이션 로그로 출력하는 발행자
+type LogPublisher struct {
+	logger *zap.Logger
+}
+
+// NewLogPublisher 로그 발행자 생성
+func NewLogPublisher(logger *zap.Logger) *LogPublisher {
+	return &LogPublisher{logger: logger}
+}
+
+// Publish 이벤트를 로그로 출력
+func (p *LogPublisher) Publish(ctx context.Context, event *point.OutboxEvent) error {
+	p.logger.Info("Point event published",
+		zap.String("event_id", event.EventID),
+		zap.String("event_type", string(event.EventType)),
+		zap.Int64("user_id", event.UserID),
+		zap.ByteString("payload", event.Payload),
+	)
+	return nil
+}
+
+// FilePublisher 이벤트 페이로드를 JSON Lines 파일에 추가하는 발행자
+type FilePublisher struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFilePublisher 파일 발행자 생성 (파일이 없으면 생성, 있으면 이어서 기록)
+func NewFilePublisher(path string) (*FilePublisher, error) {
+	if path == "" {
+		return nil, fmt.Errorf("event publisher file path is required")
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &FilePublisher{file: file}, nil
+}
+
+// Publish 이벤트 페이로드를 한 줄로 기록
+func (p *FilePublisher) Publish(ctx context.Context, event *point.OutboxEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	line := make([]byte, 0, len(event.Payload)+1)
+	line = append(line, event.Payload...)
+	line = append(line, '\n')
+	if _, err := p.file.Write(line); err != nil {
+		return err
+	}
+	return p.file.Sync()
+}
+
+// Close 파일 닫기
+func (p *FilePublisher) Close() error {
+	return p.file.Close()
+}
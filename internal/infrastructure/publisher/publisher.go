@@ -0,0 +1,61 @@
+package publisher
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"shopping-mall/internal/domain/point"
+
+	"go.uber.org/zap"
+)
+
+// 발행 방식
+const (
+	TypeLog  = "log"  // 애플리케이션 로그로 출력 (로컬 개발용)
+	TypeFile = "file" // JSON Lines 파일에 추가 (로컬 개발/디버깅용)
+	TypeHTTP = "http" // HTTP POST 로 전달
+)
+
+// Config 도메인 이벤트 발행 설정
+type Config struct {
+	Type        string        // 발행 방식 (log, file, http)
+	FilePath    string        // file 발행 시 파일 경로
+	HTTPURL     string        // http 발행 시 수신 URL
+	HTTPTimeout time.Duration // http 발행 요청 시간 제한
+}
+
+// New 설정에 맞는 이벤트 발행자 생성
+func New(cfg Config, logger *zap.Logger) (point.EventPublisher, error) {
+	switch cfg.Type {
+	case TypeLog, "":
+		return NewLogPublisher(logger), nil
+	case TypeFile:
+		return NewFilePublisher(cfg.FilePath)
+	case TypeHTTP:
+		return NewHTTPPublisher(cfg.HTTPURL, cfg.HTTPTimeout)
+	default:
+		return nil, fmt.Errorf("unknown event publisher type: %s", cfg.Type)
+	}
+}
+
+// MultiPublisher 여러 발행자에 차례로 발행하는 발행자
+// 하나라도 실패하면 실패로 처리하며, 재시도 시 이미 성공한 발행자에도 다시 발행
+type MultiPublisher struct {
+	publishers []point.EventPublisher
+}
+
+// NewMulti 여러 발행자를 묶은 발행자 생성
+func NewMulti(publishers ...point.EventPublisher) *MultiPublisher {
+	return &MultiPublisher{publishers: publishers}
+}
+
+// Publish 모든 발행자에 이벤트 발행
+func (p *MultiPublisher) Publish(ctx context.Context, event *point.OutboxEvent) error {
+	for _, publisher := range p.publishers {
+		if err := publisher.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"shopping-mall/internal/domain/orderevent"
+)
+
+// messageExt 메시지 파일 확장자
+const messageExt = ".json"
+
+// FileQueue 디렉터리의 JSON 파일을 메시지로 쓰는 큐
+// 파일 이름 순서가 메시지 순서이며, 확인한 메시지 파일은 삭제
+// 외부에서 메시지를 넣을 때도 이름이 발행 순서대로 정렬되도록 지정 (예: 발행 시각 접두어)
+type FileQueue struct {
+	dir string
+
+	mu  sync.Mutex
+	seq int64
+}
+
+// NewFileQueue 파일 큐 생성 (디렉터리가 없으면 생성)
+func NewFileQueue(dir string) (*FileQueue, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("order event queue directory is required")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileQueue{dir: dir}, nil
+}
+
+// Publish 메시지 파일 추가
+// 임시 파일에 쓴 뒤 이름을 바꿔 소비자가 쓰는 중인 파일을 읽지 않게 함
+func (q *FileQueue) Publish(ctx context.Context, body []byte) (*orderevent.Message, error) {
+	q.mu.Lock()
+	q.seq++
+	now := time.Now()
+	name := fmt.Sprintf("%020d-%06d%s", now.UnixNano(), q.seq, messageExt)
+	q.mu.Unlock()
+
+	tmp := filepath.Join(q.dir, "."+name+".tmp")
+	if err := os.WriteFile(tmp, body, 0o644); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp, filepath.Join(q.dir, name)); err != nil {
+		_ = os.Remove(tmp)
+		return nil, err
+	}
+
+	return &orderevent.Message{ID: name, Body: body, ReceivedAt: now}, nil
+}
+
+// Fetch 남아 있는 메시지 파일을 이름 순서대로 최대 limit 개 조회 (숨김 파일, 임시 파일 제외)
+func (q *FileQueue) Fetch(ctx context.Context, limit int) ([]*orderevent.Message, error) {
+	entries, err := os.ReadDir(q.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []*orderevent.Message
+	for _, entry := range entries {
+		if limit > 0 && len(messages) >= limit {
+			break
+		}
+		name := entry.Name()
+		if entry.IsDir() || strings.HasPrefix(name, ".") || filepath.Ext(name) != messageExt {
+			continue
+		}
+
+		info, err := entry.Info()
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		body, err := os.ReadFile(filepath.Join(q.dir, name))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		messages = append(messages, &orderevent.Message{
+			ID:         name,
+			Body:       body,
+			ReceivedAt: info.ModTime(),
+		})
+	}
+	return messages, nil
+}
+
+// Ack 메시지 확인 (메시지 파일 삭제)
+func (q *FileQueue) Ack(ctx context.Context, msg *orderevent.Message) error {
+	err := os.Remove(filepath.Join(q.dir, msg.ID))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,72 @@
+package queue
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"shopping-mall/internal/domain/orderevent"
+)
+
+// MemoryQueue 프로세스 내부 메시지 큐 (확인하기 전까지 메시지 유지)
+type MemoryQueue struct {
+	mu       sync.Mutex
+	seq      int64
+	messages []*orderevent.Message
+}
+
+// NewMemoryQueue 메모리 큐 생성
+func NewMemoryQueue() *MemoryQueue {
+	return &MemoryQueue{}
+}
+
+// Publish 메시지 추가
+func (q *MemoryQueue) Publish(ctx context.Context, body []byte) (*orderevent.Message, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.seq++
+	msg := &orderevent.Message{
+		ID:         strconv.FormatInt(q.seq, 10),
+		Body:       body,
+		ReceivedAt: time.Now(),
+	}
+	q.messages = append(q.messages, msg)
+	return msg, nil
+}
+
+// Fetch 확인하지 않은 메시지를 추가된 순서대로 최대 limit 개 조회
+func (q *MemoryQueue) Fetch(ctx context.Context, limit int) ([]*orderevent.Message, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	n := len(q.messages)
+	if limit > 0 && limit < n {
+		n = limit
+	}
+	messages := make([]*orderevent.Message, n)
+	copy(messages, q.messages[:n])
+	return messages, nil
+}
+
+// Ack 메시지 확인 (큐에서 삭제)
+func (q *MemoryQueue) Ack(ctx context.Context, msg *orderevent.Message) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for i, queued := range q.messages {
+		if queued.ID == msg.ID {
+			q.messages = append(q.messages[:i], q.messages[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+// Len 확인하지 않은 메시지 수
+func (q *MemoryQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.messages)
+}
@@ -0,0 +1,31 @@
+package queue
+
+import (
+	"fmt"
+
+	"shopping-mall/internal/domain/orderevent"
+)
+
+// 메시지 소스 방식
+const (
+	TypeFile   = "file"   // 디렉터리의 JSON 파일 (로컬 실행용, 재시작해도 유지)
+	TypeMemory = "memory" // 프로세스 내부 큐 (같은 프로세스에서 발행/소비, 재시작 시 유실)
+)
+
+// Config 주문 이벤트 메시지 소스 설정
+type Config struct {
+	Type string // 소스 방식 (file, memory)
+	Dir  string // file 소스의 메시지 디렉터리
+}
+
+// New 설정에 맞는 메시지 소스 생성
+func New(cfg Config) (orderevent.Source, error) {
+	switch cfg.Type {
+	case TypeFile:
+		return NewFileQueue(cfg.Dir)
+	case TypeMemory:
+		return NewMemoryQueue(), nil
+	default:
+		return nil, fmt.Errorf("unknown order event source type: %s", cfg.Type)
+	}
+}
@@ -0,0 +1,85 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"shopping-mall/internal/domain/point"
+)
+
+// 웹훅 요청 헤더
+const (
+	SignatureHeader = "X-Webhook-Signature" // "v1={hex HMAC-SHA256}"
+	TimestampHeader = "X-Webhook-Timestamp" // 서명 시각 (Unix 초)
+	DeliveryHeader  = "X-Webhook-Delivery"  // 전송 내역 ID (재전송해도 같은 값)
+	EventIDHeader   = "X-Event-ID"
+	EventTypeHeader = "X-Event-Type"
+)
+
+// signatureVersion 서명 방식 버전 접두사
+const signatureVersion = "v1="
+
+// Sender HMAC-SHA256 으로 서명한 웹훅 HTTP 전송기
+type Sender struct {
+	client *http.Client
+}
+
+// NewSender 웹훅 전송기 생성
+func NewSender(timeout time.Duration) *Sender {
+	return &Sender{
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// WithHTTPClient HTTP 클라이언트 설정 (httptest 수신기 등)
+func (s *Sender) WithHTTPClient(client *http.Client) *Sender {
+	s.client = client
+	return s
+}
+
+// Send 페이로드를 전송 시각으로 서명해 구독 URL 로 POST (2xx 가 아니면 오류)
+func (s *Sender) Send(ctx context.Context, subscription *point.WebhookSubscription, delivery *point.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, signatureVersion+point.SignWebhookPayload(subscription.Secret, timestamp, delivery.Payload))
+	req.Header.Set(DeliveryHeader, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(EventIDHeader, delivery.EventID)
+	req.Header.Set(EventTypeHeader, string(delivery.EventType))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("webhook receiver responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// Verify 수신한 웹훅 요청의 서명 검증 (수신 측/테스트 수신기용)
+// 서명 시각이 tolerance 를 벗어나면 재전송된 요청으로 보고 거부
+func Verify(r *http.Request, body []byte, secret string, tolerance time.Duration) error {
+	timestamp, err := strconv.ParseInt(r.Header.Get(TimestampHeader), 10, 64)
+	if err != nil {
+		return point.ErrInvalidWebhookSignature
+	}
+	signature := r.Header.Get(SignatureHeader)
+	if len(signature) <= len(signatureVersion) || signature[:len(signatureVersion)] != signatureVersion {
+		return point.ErrInvalidWebhookSignature
+	}
+	return point.VerifyWebhookSignature(secret, timestamp, body, signature[len(signatureVersion):], time.Now(), tolerance)
+}
@@ -0,0 +1,90 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"shopping-mall/internal/domain/point"
+)
+
+func TestSenderSignsRequest(t *testing.T) {
+	const secret = "whsec_test_0123456789abcdef"
+
+	tests := []struct {
+		name       string
+		secret     string
+		status     int
+		wantErr    bool
+		wantVerify error
+	}{
+		{"accepted", secret, http.StatusNoContent, false, nil},
+		{"receiver error", secret, http.StatusBadGateway, true, nil},
+		{"wrong secret", "whsec_other_0123456789abcdef", http.StatusOK, false, point.ErrInvalidWebhookSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var verifyErr error
+			var header http.Header
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				header = r.Header.Clone()
+				verifyErr = Verify(r, body, secret, time.Minute)
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			subscription := &point.WebhookSubscription{ID: 1, URL: server.URL, Secret: tt.secret, Active: true}
+			delivery := &point.WebhookDelivery{ID: 42, EventID: "evt-1", EventType: point.EventTypeEarned, Payload: []byte(`{"event_id":"evt-1"}`)}
+
+			statusCode, err := NewSender(time.Second).WithHTTPClient(server.Client()).Send(context.Background(), subscription, delivery)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Send() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if statusCode != tt.status {
+				t.Errorf("Send() status = %d, want %d", statusCode, tt.status)
+			}
+			if verifyErr != tt.wantVerify {
+				t.Errorf("Verify() error = %v, want %v", verifyErr, tt.wantVerify)
+			}
+			if header.Get(DeliveryHeader) != "42" || header.Get(EventIDHeader) != "evt-1" || header.Get(EventTypeHeader) != string(point.EventTypeEarned) {
+				t.Errorf("headers = %v", header)
+			}
+		})
+	}
+}
+
+func TestVerifyRejectsReplayAndMalformedSignature(t *testing.T) {
+	const secret = "whsec_test_0123456789abcdef"
+	body := []byte(`{"event_id":"evt-1"}`)
+
+	tests := []struct {
+		name      string
+		timestamp int64
+		signature func(ts int64) string
+		wantErr   error
+	}{
+		{"valid", time.Now().Unix(), func(ts int64) string { return signatureVersion + point.SignWebhookPayload(secret, ts, body) }, nil},
+		{"replayed", time.Now().Add(-time.Hour).Unix(), func(ts int64) string { return signatureVersion + point.SignWebhookPayload(secret, ts, body) }, point.ErrWebhookSignatureExpired},
+		{"missing version", time.Now().Unix(), func(ts int64) string { return point.SignWebhookPayload(secret, ts, body) }, point.ErrInvalidWebhookSignature},
+		{"empty", time.Now().Unix(), func(ts int64) string { return "" }, point.ErrInvalidWebhookSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(string(body)))
+			r.Header.Set(TimestampHeader, strconv.FormatInt(tt.timestamp, 10))
+			r.Header.Set(SignatureHeader, tt.signature(tt.timestamp))
+
+			if err := Verify(r, body, secret, 5*time.Minute); err != tt.wantErr {
+				t.Errorf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package mysql
+
+import (
+	"errors"
+
+	mysqlDriver "github.com/go-sql-driver/mysql"
+)
+
+// MySQL 에러 코드
+const (
+	errCodeDuplicateEntry   = 1062 // ER_DUP_ENTRY
+	errCodeLockWaitTimeout  = 1205 // ER_LOCK_WAIT_TIMEOUT
+	errCodeDeadlockDetected = 1213 // ER_LOCK_DEADLOCK
+)
+
+// isDuplicateKeyError 유니크 키 중복 에러 여부
+func isDuplicateKeyError(err error) bool {
+	var mysqlErr *mysqlDriver.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == errCodeDuplicateEntry
+}
+
+// isRetryableError 트랜잭션을 처음부터 다시 실행하면 성공할 수 있는 에러 여부 (데드락, 락 대기 시간 초과)
+func isRetryableError(err error) bool {
+	var mysqlErr *mysqlDriver.MySQLError
+	if !errors.As(err, &mysqlErr) {
+		return false
+	}
+	return mysqlErr.Number == errCodeDeadlockDetected || mysqlErr.Number == errCodeLockWaitTimeout
+}
@@ -0,0 +1,126 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"shopping-mall/internal/domain/idempotency"
+	"time"
+)
+
+// IdempotencyRepository 멱등성 키 리포지토리 구현
+type IdempotencyRepository struct {
+	tm *TransactionManager
+}
+
+// NewIdempotencyRepository 멱등성 키 리포지토리 생성
+func NewIdempotencyRepository(tm *TransactionManager) *IdempotencyRepository {
+	return &IdempotencyRepository{tm: tm}
+}
+
+// Create 멱등성 키 선점 (이미 있으면 ErrDuplicateKey)
+func (r *IdempotencyRepository) Create(ctx context.Context, record *idempotency.Record) error {
+	query := `
+		INSERT INTO idempotency_keys (scope, idempotency_key, request_hash, response_status, response_body, created_at, expires_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	result, err := db.ExecContext(ctx, query,
+		record.Scope,
+		record.Key,
+		record.RequestHash,
+		record.ResponseStatus,
+		record.ResponseBody,
+		time.Now(),
+		record.ExpiresAt,
+	)
+	if isDuplicateKeyError(err) {
+		return idempotency.ErrDuplicateKey
+	}
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	record.ID = id
+	return nil
+}
+
+// Get 멱등성 키 조회 (락 포함)
+func (r *IdempotencyRepository) Get(ctx context.Context, scope, key string) (*idempotency.Record, error) {
+	query := `
+		SELECT id, scope, idempotency_key, request_hash, response_status, response_body, created_at, expires_at
+		FROM idempotency_keys
+		WHERE scope = ? AND idempotency_key = ?
+		FOR UPDATE
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	row := db.QueryRowContext(ctx, query, scope, key)
+
+	var record idempotency.Record
+	err := row.Scan(
+		&record.ID,
+		&record.Scope,
+		&record.Key,
+		&record.RequestHash,
+		&record.ResponseStatus,
+		&record.ResponseBody,
+		&record.CreatedAt,
+		&record.ExpiresAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, idempotency.ErrRecordNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+// UpdateResponse 응답 기록
+func (r *IdempotencyRepository) UpdateResponse(ctx context.Context, record *idempotency.Record) error {
+	query := `
+		UPDATE idempotency_keys
+		SET response_status = ?, response_body = ?
+		WHERE id = ?
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	_, err := db.ExecContext(ctx, query, record.ResponseStatus, record.ResponseBody, record.ID)
+	return err
+}
+
+// Delete 멱등성 키 삭제
+func (r *IdempotencyRepository) Delete(ctx context.Context, id int64) error {
+	query := `
+		DELETE FROM idempotency_keys
+		WHERE id = ?
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	_, err := db.ExecContext(ctx, query, id)
+	return err
+}
+
+// DeleteExpired before 이전에 만료된 키를 최대 limit 개 삭제 (삭제한 개수 반환)
+func (r *IdempotencyRepository) DeleteExpired(ctx context.Context, before time.Time, limit int) (int64, error) {
+	query := `
+		DELETE FROM idempotency_keys
+		WHERE expires_at < ?
+		ORDER BY expires_at ASC
+		LIMIT ?
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	result, err := db.ExecContext(ctx, query, before, limit)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,144 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"shopping-mall/internal/domain/orderevent"
+)
+
+// maxParkReasonLength parked_order_events.reason, order_event_attempts.last_error 컬럼 최대 길이
+const maxParkReasonLength = 500
+
+// OrderEventRepository 주문 이벤트 처리 기록 리포지토리 구현
+type OrderEventRepository struct {
+	tm *TransactionManager
+}
+
+// NewOrderEventRepository 주문 이벤트 처리 기록 리포지토리 생성
+func NewOrderEventRepository(tm *TransactionManager) *OrderEventRepository {
+	return &OrderEventRepository{tm: tm}
+}
+
+// CreateProcessedEvent 처리한 이벤트 기록 (이미 있으면 ErrDuplicateEvent)
+func (r *OrderEventRepository) CreateProcessedEvent(ctx context.Context, processed *orderevent.ProcessedEvent) error {
+	query := `
+		INSERT INTO consumed_order_events (event_id, event_type, order_id, user_id, occurred_at, processed_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	_, err := db.ExecContext(ctx, query,
+		processed.EventID,
+		processed.EventType,
+		processed.OrderID,
+		processed.UserID,
+		sql.NullTime{Time: processed.OccurredAt, Valid: !processed.OccurredAt.IsZero()},
+		processed.ProcessedAt,
+	)
+	if isDuplicateKeyError(err) {
+		return orderevent.ErrDuplicateEvent
+	}
+	return err
+}
+
+// CreateParkedMessage 처리할 수 없는 메시지 보관 (같은 메시지가 이미 보관되어 있으면 무시)
+func (r *OrderEventRepository) CreateParkedMessage(ctx context.Context, parked *orderevent.ParkedMessage) error {
+	query := `
+		INSERT IGNORE INTO parked_order_events
+		(message_id, event_id, event_type, body, reason, attempts, parked_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	reason := parked.Reason
+	if len(reason) > maxParkReasonLength {
+		reason = reason[:maxParkReasonLength]
+	}
+
+	db := r.tm.GetDBOrTx(ctx)
+	result, err := db.ExecContext(ctx, query,
+		parked.MessageID,
+		nullString(parked.EventID),
+		nullString(string(parked.EventType)),
+		string(parked.Body),
+		reason,
+		parked.Attempts,
+		parked.ParkedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	parked.ID = id
+	return nil
+}
+
+// GetMessageAttempt 메시지 처리 실패 기록 조회 (없으면 ErrAttemptNotFound)
+func (r *OrderEventRepository) GetMessageAttempt(ctx context.Context, messageID string) (*orderevent.MessageAttempt, error) {
+	query := `
+		SELECT message_id, attempts, next_attempt_at, last_error, updated_at
+		FROM order_event_attempts
+		WHERE message_id = ?
+	`
+
+	attempt := &orderevent.MessageAttempt{}
+	db := r.tm.GetDBOrTx(ctx)
+	err := db.QueryRowContext(ctx, query, messageID).Scan(
+		&attempt.MessageID,
+		&attempt.Attempts,
+		&attempt.NextAttemptAt,
+		&attempt.LastError,
+		&attempt.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, orderevent.ErrAttemptNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return attempt, nil
+}
+
+// SaveMessageAttempt 메시지 처리 실패 기록 저장 (없으면 생성, 있으면 갱신)
+func (r *OrderEventRepository) SaveMessageAttempt(ctx context.Context, attempt *orderevent.MessageAttempt) error {
+	query := `
+		INSERT INTO order_event_attempts (message_id, attempts, next_attempt_at, last_error, updated_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			attempts = VALUES(attempts),
+			next_attempt_at = VALUES(next_attempt_at),
+			last_error = VALUES(last_error),
+			updated_at = VALUES(updated_at)
+	`
+
+	lastError := attempt.LastError
+	if len(lastError) > maxParkReasonLength {
+		lastError = lastError[:maxParkReasonLength]
+	}
+
+	db := r.tm.GetDBOrTx(ctx)
+	_, err := db.ExecContext(ctx, query,
+		attempt.MessageID,
+		attempt.Attempts,
+		attempt.NextAttemptAt,
+		lastError,
+		attempt.UpdatedAt,
+	)
+	return err
+}
+
+// DeleteMessageAttempt 처리가 끝난 메시지의 실패 기록 삭제
+func (r *OrderEventRepository) DeleteMessageAttempt(ctx context.Context, messageID string) error {
+	query := `
+		DELETE FROM order_event_attempts
+		WHERE message_id = ?
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	_, err := db.ExecContext(ctx, query, messageID)
+	return err
+}
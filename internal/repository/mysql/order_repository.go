@@ -0,0 +1,139 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"shopping-mall/internal/domain/order"
+	"time"
+)
+
+// orderColumns orders 조회 컬럼 목록
+const orderColumns = `id, user_id, total_amount, point_used, point_to_earn, payment_amount, status, confirmed_at, created_at, updated_at`
+
+// OrderRepository 주문 리포지토리 구현
+type OrderRepository struct {
+	tm *TransactionManager
+}
+
+// NewOrderRepository 주문 리포지토리 생성
+func NewOrderRepository(tm *TransactionManager) *OrderRepository {
+	return &OrderRepository{tm: tm}
+}
+
+// Create 주문 생성 (ID 는 주문 서비스의 주문 ID 사용)
+func (r *OrderRepository) Create(ctx context.Context, o *order.Order) error {
+	query := `
+		INSERT INTO orders
+		(id, user_id, total_amount, point_used, point_to_earn, payment_amount, status, confirmed_at, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	_, err := db.ExecContext(ctx, query,
+		o.ID,
+		o.UserID,
+		o.TotalAmount,
+		o.PointUsed,
+		o.PointToEarn,
+		o.PaymentAmount,
+		o.Status,
+		o.ConfirmedAt,
+		o.CreatedAt,
+		o.UpdatedAt,
+	)
+	return err
+}
+
+// Get 주문 조회
+func (r *OrderRepository) Get(ctx context.Context, id int64) (*order.Order, error) {
+	query := `
+		SELECT ` + orderColumns + `
+		FROM orders
+		WHERE id = ?
+	`
+
+	db := r.tm.GetReadDBOrTx(ctx)
+	o, err := scanOrder(db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, order.ErrOrderNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// GetForUpdate 주문 조회 (락 포함)
+func (r *OrderRepository) GetForUpdate(ctx context.Context, id int64) (*order.Order, error) {
+	query := `
+		SELECT ` + orderColumns + `
+		FROM orders
+		WHERE id = ?
+		FOR UPDATE
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	o, err := scanOrder(db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, order.ErrOrderNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// Update 주문 상태/금액 업데이트
+func (r *OrderRepository) Update(ctx context.Context, o *order.Order) error {
+	query := `
+		UPDATE orders
+		SET total_amount = ?, point_used = ?, point_to_earn = ?, payment_amount = ?, status = ?, confirmed_at = ?, updated_at = ?
+		WHERE id = ?
+	`
+
+	now := time.Now()
+	db := r.tm.GetDBOrTx(ctx)
+	_, err := db.ExecContext(ctx, query,
+		o.TotalAmount,
+		o.PointUsed,
+		o.PointToEarn,
+		o.PaymentAmount,
+		o.Status,
+		o.ConfirmedAt,
+		now,
+		o.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	o.UpdatedAt = now
+	return nil
+}
+
+// scanOrder orderColumns 순서로 주문 스캔
+func scanOrder(s rowScanner) (*order.Order, error) {
+	var o order.Order
+	var confirmedAt sql.NullTime
+
+	err := s.Scan(
+		&o.ID,
+		&o.UserID,
+		&o.TotalAmount,
+		&o.PointUsed,
+		&o.PointToEarn,
+		&o.PaymentAmount,
+		&o.Status,
+		&confirmedAt,
+		&o.CreatedAt,
+		&o.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if confirmedAt.Valid {
+		o.ConfirmedAt = &confirmedAt.Time
+	}
+	return &o, nil
+}
@@ -0,0 +1,194 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"shopping-mall/internal/domain/point"
+	"time"
+)
+
+// approvalColumns point_adjustment_approvals 조회 컬럼 목록
+const approvalColumns = `id, direction, user_id, amount, reason, ticket_ref, expires_in_days, wallet, requested_by, reviewed_by,
+	review_note, transaction_id, status, expires_at, reviewed_at, created_at, updated_at`
+
+// CreateAdjustmentApproval 관리자 조정 승인 요청 생성
+func (r *PointRepository) CreateAdjustmentApproval(ctx context.Context, approval *point.AdjustmentApproval) error {
+	query := `
+		INSERT INTO point_adjustment_approvals
+		(direction, user_id, amount, reason, ticket_ref, expires_in_days, wallet, requested_by, status, expires_at, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	now := time.Now()
+	db := r.tm.GetDBOrTx(ctx)
+	result, err := db.ExecContext(ctx, query,
+		approval.Direction,
+		approval.UserID,
+		approval.Amount,
+		approval.Reason,
+		approval.TicketRef,
+		approval.ExpiresInDays,
+		nullString(string(approval.Wallet)),
+		approval.RequestedBy,
+		approval.Status,
+		approval.ExpiresAt,
+		now,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	approval.ID = id
+	approval.CreatedAt = now
+	approval.UpdatedAt = now
+	return nil
+}
+
+// UpdateAdjustmentApproval 관리자 조정 승인 요청 업데이트
+func (r *PointRepository) UpdateAdjustmentApproval(ctx context.Context, approval *point.AdjustmentApproval) error {
+	query := `
+		UPDATE point_adjustment_approvals
+		SET status = ?, reviewed_by = ?, review_note = ?, transaction_id = ?, reviewed_at = ?, updated_at = ?
+		WHERE id = ?
+	`
+
+	now := time.Now()
+	db := r.tm.GetDBOrTx(ctx)
+	_, err := db.ExecContext(ctx, query,
+		approval.Status,
+		nullString(approval.ReviewedBy),
+		nullString(approval.ReviewNote),
+		approval.TransactionID,
+		approval.ReviewedAt,
+		now,
+		approval.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	approval.UpdatedAt = now
+	return nil
+}
+
+// GetAdjustmentApprovalForUpdate 관리자 조정 승인 요청 조회 (락 포함)
+func (r *PointRepository) GetAdjustmentApprovalForUpdate(ctx context.Context, id int64) (*point.AdjustmentApproval, error) {
+	query := `
+		SELECT ` + approvalColumns + `
+		FROM point_adjustment_approvals
+		WHERE id = ?
+		FOR UPDATE
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	approval, err := scanApproval(db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, point.ErrApprovalNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return approval, nil
+}
+
+// GetAdjustmentApprovals 관리자 조정 승인 요청 목록 조회 (status 가 비어 있으면 전체, 최신순)
+func (r *PointRepository) GetAdjustmentApprovals(ctx context.Context, status point.ApprovalStatus, limit, offset int) ([]*point.AdjustmentApproval, error) {
+	query := `
+		SELECT ` + approvalColumns + `
+		FROM point_adjustment_approvals
+		WHERE (? = '' OR status = ?)
+		ORDER BY id DESC
+		LIMIT ? OFFSET ?
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	rows, err := db.QueryContext(ctx, query, status, status, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return scanApprovals(rows)
+}
+
+// GetStaleAdjustmentApprovals 승인 대기 시간이 지난 승인 요청 조회
+func (r *PointRepository) GetStaleAdjustmentApprovals(ctx context.Context, before time.Time, limit int) ([]*point.AdjustmentApproval, error) {
+	query := `
+		SELECT ` + approvalColumns + `
+		FROM point_adjustment_approvals
+		WHERE status = 'PENDING'
+		  AND expires_at <= ?
+		ORDER BY expires_at ASC
+		LIMIT ?
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	rows, err := db.QueryContext(ctx, query, before, limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanApprovals(rows)
+}
+
+// scanApproval approvalColumns 순서로 관리자 조정 승인 요청 스캔
+func scanApproval(s rowScanner) (*point.AdjustmentApproval, error) {
+	var approval point.AdjustmentApproval
+	var reviewedBy, reviewNote, wallet sql.NullString
+	var transactionID sql.NullInt64
+	var reviewedAt sql.NullTime
+
+	err := s.Scan(
+		&approval.ID,
+		&approval.Direction,
+		&approval.UserID,
+		&approval.Amount,
+		&approval.Reason,
+		&approval.TicketRef,
+		&approval.ExpiresInDays,
+		&wallet,
+		&approval.RequestedBy,
+		&reviewedBy,
+		&reviewNote,
+		&transactionID,
+		&approval.Status,
+		&approval.ExpiresAt,
+		&reviewedAt,
+		&approval.CreatedAt,
+		&approval.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	approval.Wallet = point.Wallet(wallet.String)
+	approval.ReviewedBy = reviewedBy.String
+	approval.ReviewNote = reviewNote.String
+	if transactionID.Valid {
+		approval.TransactionID = &transactionID.Int64
+	}
+	if reviewedAt.Valid {
+		approval.ReviewedAt = &reviewedAt.Time
+	}
+
+	return &approval, nil
+}
+
+// scanApprovals 관리자 조정 승인 요청 목록 스캔 (rows 는 내부에서 닫음)
+func scanApprovals(rows *sql.Rows) ([]*point.AdjustmentApproval, error) {
+	defer rows.Close()
+
+	var approvals []*point.AdjustmentApproval
+	for rows.Next() {
+		approval, err := scanApproval(rows)
+		if err != nil {
+			return nil, err
+		}
+		approvals = append(approvals, approval)
+	}
+
+	return approvals, rows.Err()
+}
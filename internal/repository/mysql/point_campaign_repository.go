@@ -0,0 +1,267 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"shopping-mall/internal/domain/point"
+	"time"
+)
+
+// campaignColumns point_campaigns 조회 컬럼 목록
+const campaignColumns = `id, name, bonus_type, bonus_value, starts_at, ends_at, wallet, tiers, reason_types, categories,
+	min_payment_amount, user_budget, total_budget, spent_budget, created_at, updated_at`
+
+// CreateCampaign 프로모션 캠페인 생성
+func (r *PointRepository) CreateCampaign(ctx context.Context, campaign *point.Campaign) error {
+	query := `
+		INSERT INTO point_campaigns
+		(name, bonus_type, bonus_value, starts_at, ends_at, wallet, tiers, reason_types, categories,
+		 min_payment_amount, user_budget, total_budget, spent_budget, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	tiers, err := jsonList(campaign.Tiers)
+	if err != nil {
+		return err
+	}
+	reasonTypes, err := jsonList(campaign.ReasonTypes)
+	if err != nil {
+		return err
+	}
+	categories, err := jsonList(campaign.Categories)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	db := r.tm.GetDBOrTx(ctx)
+	result, err := db.ExecContext(ctx, query,
+		campaign.Name,
+		campaign.BonusType,
+		campaign.BonusValue,
+		campaign.StartsAt,
+		campaign.EndsAt,
+		campaign.Wallet.OrDefault(),
+		tiers,
+		reasonTypes,
+		categories,
+		campaign.MinPaymentAmount,
+		campaign.UserBudget,
+		campaign.TotalBudget,
+		campaign.SpentBudget,
+		now,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	campaign.ID = id
+	campaign.CreatedAt = now
+	campaign.UpdatedAt = now
+	return nil
+}
+
+// UpdateCampaignSpent 캠페인 지급 예산 누계 업데이트
+func (r *PointRepository) UpdateCampaignSpent(ctx context.Context, campaign *point.Campaign) error {
+	query := `
+		UPDATE point_campaigns
+		SET spent_budget = ?, updated_at = ?
+		WHERE id = ?
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	_, err := db.ExecContext(ctx, query, campaign.SpentBudget, time.Now(), campaign.ID)
+	return err
+}
+
+// GetCampaign 캠페인 조회 (락 없음, 조회 전용)
+func (r *PointRepository) GetCampaign(ctx context.Context, id int64) (*point.Campaign, error) {
+	query := `
+		SELECT ` + campaignColumns + `
+		FROM point_campaigns
+		WHERE id = ?
+	`
+	return r.getCampaign(ctx, query, id)
+}
+
+// GetCampaignForUpdate 캠페인 조회 (락 포함, 예산 차감 시 사용)
+func (r *PointRepository) GetCampaignForUpdate(ctx context.Context, id int64) (*point.Campaign, error) {
+	query := `
+		SELECT ` + campaignColumns + `
+		FROM point_campaigns
+		WHERE id = ?
+		FOR UPDATE
+	`
+	return r.getCampaign(ctx, query, id)
+}
+
+// getCampaign 캠페인 조회 공통 처리
+func (r *PointRepository) getCampaign(ctx context.Context, query string, id int64) (*point.Campaign, error) {
+	db := r.tm.GetDBOrTx(ctx)
+	campaign, err := scanCampaign(db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, point.ErrCampaignNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return campaign, nil
+}
+
+// GetActiveCampaigns at 시점에 진행 중인 캠페인 조회
+func (r *PointRepository) GetActiveCampaigns(ctx context.Context, at time.Time) ([]*point.Campaign, error) {
+	query := `
+		SELECT ` + campaignColumns + `
+		FROM point_campaigns
+		WHERE starts_at <= ? AND ends_at > ?
+		ORDER BY id ASC
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	rows, err := db.QueryContext(ctx, query, at, at)
+	if err != nil {
+		return nil, err
+	}
+	return scanCampaigns(rows)
+}
+
+// GetCampaigns 캠페인 목록 조회 (최신순)
+func (r *PointRepository) GetCampaigns(ctx context.Context, limit, offset int) ([]*point.Campaign, error) {
+	query := `
+		SELECT ` + campaignColumns + `
+		FROM point_campaigns
+		ORDER BY id DESC
+		LIMIT ? OFFSET ?
+	`
+
+	db := r.tm.GetReadDBOrTx(ctx)
+	rows, err := db.QueryContext(ctx, query, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return scanCampaigns(rows)
+}
+
+// GetCampaignUserBonus 사용자가 캠페인으로 받은 추가 적립 합계 (취소 제외)
+func (r *PointRepository) GetCampaignUserBonus(ctx context.Context, campaignID, userID int64) (int64, error) {
+	query := `
+		SELECT COALESCE(SUM(amount), 0)
+		FROM point_transactions
+		WHERE campaign_id = ? AND user_id = ? AND status <> 'CANCELLED'
+	`
+
+	var total int64
+	db := r.tm.GetDBOrTx(ctx)
+	if err := db.QueryRowContext(ctx, query, campaignID, userID).Scan(&total); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// GetCampaignCost 캠페인 추가 적립 비용 집계
+func (r *PointRepository) GetCampaignCost(ctx context.Context, campaignID int64) (*point.CampaignCost, error) {
+	query := `
+		SELECT
+			COUNT(DISTINCT user_id),
+			COALESCE(SUM(CASE WHEN status = 'PENDING' THEN amount ELSE 0 END), 0),
+			COALESCE(SUM(CASE WHEN status = 'CONFIRMED' THEN amount ELSE 0 END), 0),
+			COALESCE(SUM(CASE WHEN status = 'CANCELLED' THEN amount ELSE 0 END), 0)
+		FROM point_transactions
+		WHERE campaign_id = ?
+	`
+
+	cost := &point.CampaignCost{CampaignID: campaignID}
+	db := r.tm.GetReadDBOrTx(ctx)
+	err := db.QueryRowContext(ctx, query, campaignID).Scan(
+		&cost.UserCount,
+		&cost.PendingAmount,
+		&cost.ConfirmedAmount,
+		&cost.CancelledAmount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return cost, nil
+}
+
+// jsonList 목록을 JSON 으로 변환 (비어 있으면 NULL)
+func jsonList[T any](values []T) (sql.NullString, error) {
+	if len(values) == 0 {
+		return sql.NullString{}, nil
+	}
+	encoded, err := json.Marshal(values)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: string(encoded), Valid: true}, nil
+}
+
+// parseJSONList JSON 목록 파싱 (NULL 이면 nil)
+func parseJSONList[T any](value sql.NullString, dest *[]T) error {
+	if !value.Valid || value.String == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(value.String), dest)
+}
+
+// scanCampaign campaignColumns 순서로 캠페인 스캔
+func scanCampaign(s rowScanner) (*point.Campaign, error) {
+	var campaign point.Campaign
+	var tiers, reasonTypes, categories sql.NullString
+
+	err := s.Scan(
+		&campaign.ID,
+		&campaign.Name,
+		&campaign.BonusType,
+		&campaign.BonusValue,
+		&campaign.StartsAt,
+		&campaign.EndsAt,
+		&campaign.Wallet,
+		&tiers,
+		&reasonTypes,
+		&categories,
+		&campaign.MinPaymentAmount,
+		&campaign.UserBudget,
+		&campaign.TotalBudget,
+		&campaign.SpentBudget,
+		&campaign.CreatedAt,
+		&campaign.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := parseJSONList(tiers, &campaign.Tiers); err != nil {
+		return nil, err
+	}
+	if err := parseJSONList(reasonTypes, &campaign.ReasonTypes); err != nil {
+		return nil, err
+	}
+	if err := parseJSONList(categories, &campaign.Categories); err != nil {
+		return nil, err
+	}
+	return &campaign, nil
+}
+
+// scanCampaigns 캠페인 목록 스캔 (rows 는 내부에서 닫음)
+func scanCampaigns(rows *sql.Rows) ([]*point.Campaign, error) {
+	defer rows.Close()
+
+	var campaigns []*point.Campaign
+	for rows.Next() {
+		campaign, err := scanCampaign(rows)
+		if err != nil {
+			return nil, err
+		}
+		campaigns = append(campaigns, campaign)
+	}
+
+	return campaigns, rows.Err()
+}
@@ -0,0 +1,153 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"shopping-mall/internal/domain/point"
+	"time"
+)
+
+// orderLineColumns point_order_lines 조회 컬럼 목록
+const orderLineColumns = `id, order_id, user_id, line_no, sku, category, quantity, unit_price, discount, point_eligible,
+	earn_rate, earned_points, refunded_quantity, clawed_back_points, created_at, updated_at`
+
+// GetEarnRules 카테고리/상품별 적립 규칙 조회
+func (r *PointRepository) GetEarnRules(ctx context.Context) ([]*point.EarnRule, error) {
+	query := `
+		SELECT scope, target, earn_rate, excluded
+		FROM point_earn_rules
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []*point.EarnRule
+	for rows.Next() {
+		var rule point.EarnRule
+		var earnRate sql.NullFloat64
+		if err := rows.Scan(&rule.Scope, &rule.Target, &earnRate, &rule.Excluded); err != nil {
+			return nil, err
+		}
+		if earnRate.Valid {
+			rule.EarnRate = &earnRate.Float64
+		}
+		rules = append(rules, &rule)
+	}
+
+	return rules, rows.Err()
+}
+
+// CreateOrderLine 주문 라인 적립 내역 생성
+func (r *PointRepository) CreateOrderLine(ctx context.Context, line *point.OrderLine) error {
+	query := `
+		INSERT INTO point_order_lines
+		(order_id, user_id, line_no, sku, category, quantity, unit_price, discount, point_eligible,
+		 earn_rate, earned_points, refunded_quantity, clawed_back_points, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	now := time.Now()
+	db := r.tm.GetDBOrTx(ctx)
+	result, err := db.ExecContext(ctx, query,
+		line.OrderID,
+		line.UserID,
+		line.LineNo,
+		line.SKU,
+		line.Category,
+		line.Quantity,
+		line.UnitPrice,
+		line.Discount,
+		line.PointEligible,
+		line.EarnRate,
+		line.EarnedPoints,
+		line.RefundedQuantity,
+		line.ClawedBackPoints,
+		now,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	line.ID = id
+	line.CreatedAt = now
+	line.UpdatedAt = now
+	return nil
+}
+
+// UpdateOrderLine 주문 라인 환불 수량/회수 포인트 업데이트
+func (r *PointRepository) UpdateOrderLine(ctx context.Context, line *point.OrderLine) error {
+	query := `
+		UPDATE point_order_lines
+		SET refunded_quantity = ?, clawed_back_points = ?, updated_at = ?
+		WHERE id = ?
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	_, err := db.ExecContext(ctx, query, line.RefundedQuantity, line.ClawedBackPoints, time.Now(), line.ID)
+	return err
+}
+
+// GetOrderLines 주문 라인 적립 내역 조회 (라인 번호 순)
+func (r *PointRepository) GetOrderLines(ctx context.Context, orderID int64) ([]*point.OrderLine, error) {
+	query := `
+		SELECT ` + orderLineColumns + `
+		FROM point_order_lines
+		WHERE order_id = ?
+		ORDER BY line_no ASC
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	rows, err := db.QueryContext(ctx, query, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var lines []*point.OrderLine
+	for rows.Next() {
+		line, err := scanOrderLine(rows)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, line)
+	}
+
+	return lines, rows.Err()
+}
+
+// scanOrderLine orderLineColumns 순서로 주문 라인 스캔
+func scanOrderLine(s rowScanner) (*point.OrderLine, error) {
+	var line point.OrderLine
+	err := s.Scan(
+		&line.ID,
+		&line.OrderID,
+		&line.UserID,
+		&line.LineNo,
+		&line.SKU,
+		&line.Category,
+		&line.Quantity,
+		&line.UnitPrice,
+		&line.Discount,
+		&line.PointEligible,
+		&line.EarnRate,
+		&line.EarnedPoints,
+		&line.RefundedQuantity,
+		&line.ClawedBackPoints,
+		&line.CreatedAt,
+		&line.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &line, nil
+}
@@ -0,0 +1,150 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"shopping-mall/internal/domain/point"
+	"time"
+)
+
+// outboxColumns point_outbox_events 조회 컬럼 목록
+const outboxColumns = `id, event_id, event_type, user_id, transaction_id, payload, status, attempts,
+	next_attempt_at, last_error, published_at, created_at`
+
+// maxOutboxErrorLength last_error 컬럼 최대 길이
+const maxOutboxErrorLength = 500
+
+// CreateOutboxEvent 도메인 이벤트를 outbox 에 저장
+func (r *PointRepository) CreateOutboxEvent(ctx context.Context, event *point.OutboxEvent) error {
+	query := `
+		INSERT INTO point_outbox_events
+		(event_id, event_type, user_id, transaction_id, payload, status, attempts, next_attempt_at, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	result, err := db.ExecContext(ctx, query,
+		event.EventID,
+		event.EventType,
+		event.UserID,
+		event.TransactionID,
+		string(event.Payload),
+		event.Status,
+		event.Attempts,
+		event.NextAttemptAt,
+		event.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	event.ID = id
+	return nil
+}
+
+// UpdateOutboxEvent outbox 이벤트 발행 상태 업데이트
+func (r *PointRepository) UpdateOutboxEvent(ctx context.Context, event *point.OutboxEvent) error {
+	query := `
+		UPDATE point_outbox_events
+		SET status = ?, attempts = ?, next_attempt_at = ?, last_error = ?, published_at = ?
+		WHERE id = ?
+	`
+
+	lastError := event.LastError
+	if len(lastError) > maxOutboxErrorLength {
+		lastError = lastError[:maxOutboxErrorLength]
+	}
+
+	db := r.tm.GetDBOrTx(ctx)
+	_, err := db.ExecContext(ctx, query,
+		event.Status,
+		event.Attempts,
+		event.NextAttemptAt,
+		nullString(lastError),
+		event.PublishedAt,
+		event.ID,
+	)
+	return err
+}
+
+// GetDispatchableOutboxEvents now 시점에 발행할 이벤트 조회 (ID 순)
+// 같은 사용자의 앞선 이벤트가 재시도 대기 중이면 뒤 이벤트는 제외해 사용자별 발행 순서 유지
+func (r *PointRepository) GetDispatchableOutboxEvents(ctx context.Context, now time.Time, limit int) ([]*point.OutboxEvent, error) {
+	query := `
+		SELECT ` + outboxColumns + `
+		FROM point_outbox_events e
+		WHERE e.status = 'PENDING'
+		  AND e.next_attempt_at <= ?
+		  AND NOT EXISTS (
+			SELECT 1
+			FROM point_outbox_events p
+			WHERE p.user_id = e.user_id
+			  AND p.status = 'PENDING'
+			  AND p.id < e.id
+			  AND p.next_attempt_at > ?
+		  )
+		ORDER BY e.id ASC
+		LIMIT ?
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	rows, err := db.QueryContext(ctx, query, now, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*point.OutboxEvent
+	for rows.Next() {
+		event, err := scanOutboxEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+// scanOutboxEvent outboxColumns 순서로 outbox 이벤트 스캔
+func scanOutboxEvent(s rowScanner) (*point.OutboxEvent, error) {
+	var event point.OutboxEvent
+	var transactionID sql.NullInt64
+	var payload string
+	var lastError sql.NullString
+	var publishedAt sql.NullTime
+
+	err := s.Scan(
+		&event.ID,
+		&event.EventID,
+		&event.EventType,
+		&event.UserID,
+		&transactionID,
+		&payload,
+		&event.Status,
+		&event.Attempts,
+		&event.NextAttemptAt,
+		&lastError,
+		&publishedAt,
+		&event.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	event.Payload = []byte(payload)
+	event.LastError = lastError.String
+	if transactionID.Valid {
+		event.TransactionID = &transactionID.Int64
+	}
+	if publishedAt.Valid {
+		event.PublishedAt = &publishedAt.Time
+	}
+
+	return &event, nil
+}
@@ -0,0 +1,127 @@
+package mysql
+
+import (
+	"context"
+	"shopping-mall/internal/domain/point"
+	"time"
+)
+
+// GetUserIDsAfter afterUserID 다음 사용자 ID 목록 조회 (user_id 오름차순, 배치 처리용)
+func (r *PointRepository) GetUserIDsAfter(ctx context.Context, afterUserID int64, limit int) ([]int64, error) {
+	query := `
+		SELECT user_id
+		FROM user_points
+		WHERE user_id > ?
+		ORDER BY user_id ASC
+		LIMIT ?
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	rows, err := db.QueryContext(ctx, query, afterUserID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var userIDs []int64
+	for rows.Next() {
+		var userID int64
+		if err := rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	return userIDs, rows.Err()
+}
+
+// GetLedgerSummary 사용자 거래 내역으로 잔액 재계산
+// - 적립(환불 복구 제외)은 적립 확정(earned_at)된 경우만 누적 적립/순잔액에 반영 (이후 취소되어도 회수 거래로 차감)
+// - 환불 복구 적립은 순잔액에만 반영
+// - 사용은 확정/예약 모두 순잔액에서 차감, 예약 중(PENDING)인 사용은 예약 포인트로 집계
+// - 만료/회수는 순잔액에서 차감
+func (r *PointRepository) GetLedgerSummary(ctx context.Context, userID int64) (*point.LedgerSummary, error) {
+	query := `
+		SELECT
+			COALESCE(SUM(CASE
+				WHEN transaction_type = 'EARN' AND reason_type = 'REFUND' AND status = 'CONFIRMED' THEN amount
+				WHEN transaction_type = 'EARN' AND reason_type <> 'REFUND' AND earned_at IS NOT NULL THEN amount
+				WHEN transaction_type = 'USE' AND status IN ('CONFIRMED', 'PENDING') THEN -amount
+				WHEN transaction_type IN ('EXPIRE', 'CANCEL') THEN -amount
+				ELSE 0
+			END), 0) AS net_balance,
+			COALESCE(SUM(CASE
+				WHEN transaction_type = 'EARN' AND status = 'PENDING' THEN amount
+				ELSE 0
+			END), 0) AS pending_balance,
+			COALESCE(SUM(CASE
+				WHEN transaction_type = 'USE' AND status = 'PENDING' THEN amount
+				ELSE 0
+			END), 0) AS held_balance,
+			COALESCE(SUM(CASE
+				WHEN transaction_type = 'EARN' AND reason_type <> 'REFUND' AND earned_at IS NOT NULL THEN amount
+				ELSE 0
+			END), 0) AS total_earned,
+			COALESCE(SUM(CASE
+				WHEN transaction_type = 'USE' AND status = 'CONFIRMED' THEN amount
+				ELSE 0
+			END), 0) AS total_used
+		FROM point_transactions
+		WHERE user_id = ?
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	summary := &point.LedgerSummary{UserID: userID}
+	err := db.QueryRowContext(ctx, query, userID).Scan(
+		&summary.NetBalance,
+		&summary.PendingBalance,
+		&summary.HeldBalance,
+		&summary.TotalEarned,
+		&summary.TotalUsed,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+// CreateReconciliation 정합성 검증 불일치 리포트 생성
+func (r *PointRepository) CreateReconciliation(ctx context.Context, reconciliation *point.Reconciliation) error {
+	query := `
+		INSERT INTO point_reconciliations
+		(user_id, expected_net_balance, actual_net_balance, expected_pending_balance, actual_pending_balance,
+		 expected_held_balance, actual_held_balance, expected_total_earned, actual_total_earned,
+		 expected_total_used, actual_total_used, corrected, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	now := time.Now()
+	db := r.tm.GetDBOrTx(ctx)
+	result, err := db.ExecContext(ctx, query,
+		reconciliation.UserID,
+		reconciliation.ExpectedNetBalance,
+		reconciliation.ActualNetBalance,
+		reconciliation.ExpectedPendingBalance,
+		reconciliation.ActualPendingBalance,
+		reconciliation.ExpectedHeldBalance,
+		reconciliation.ActualHeldBalance,
+		reconciliation.ExpectedTotalEarned,
+		reconciliation.ActualTotalEarned,
+		reconciliation.ExpectedTotalUsed,
+		reconciliation.ActualTotalUsed,
+		reconciliation.Corrected,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	reconciliation.ID = id
+	reconciliation.CreatedAt = now
+	return nil
+}
@@ -0,0 +1,100 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"shopping-mall/internal/domain/point"
+	"time"
+)
+
+// GetOrderRefund 주문 부분 환불 내역 조회 (락 포함)
+func (r *PointRepository) GetOrderRefund(ctx context.Context, orderID int64) (*point.OrderRefund, error) {
+	query := `
+		SELECT id, order_id, user_id, payment_amount, used_point_amount, earned_point_amount,
+		       refunded_payment_amount, restored_point_amount, clawed_back_point_amount, created_at, updated_at
+		FROM point_order_refunds
+		WHERE order_id = ?
+		FOR UPDATE
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	row := db.QueryRowContext(ctx, query, orderID)
+
+	var refund point.OrderRefund
+	err := row.Scan(
+		&refund.ID,
+		&refund.OrderID,
+		&refund.UserID,
+		&refund.PaymentAmount,
+		&refund.UsedPointAmount,
+		&refund.EarnedPointAmount,
+		&refund.RefundedPaymentAmount,
+		&refund.RestoredPointAmount,
+		&refund.ClawedBackPointAmount,
+		&refund.CreatedAt,
+		&refund.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, point.ErrOrderRefundNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &refund, nil
+}
+
+// CreateOrderRefund 주문 부분 환불 내역 생성
+func (r *PointRepository) CreateOrderRefund(ctx context.Context, refund *point.OrderRefund) error {
+	query := `
+		INSERT INTO point_order_refunds
+		(order_id, user_id, payment_amount, used_point_amount, earned_point_amount,
+		 refunded_payment_amount, restored_point_amount, clawed_back_point_amount, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	now := time.Now()
+	db := r.tm.GetDBOrTx(ctx)
+	result, err := db.ExecContext(ctx, query,
+		refund.OrderID,
+		refund.UserID,
+		refund.PaymentAmount,
+		refund.UsedPointAmount,
+		refund.EarnedPointAmount,
+		refund.RefundedPaymentAmount,
+		refund.RestoredPointAmount,
+		refund.ClawedBackPointAmount,
+		now,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	refund.ID = id
+	return nil
+}
+
+// UpdateOrderRefund 주문 부분 환불 내역 업데이트
+func (r *PointRepository) UpdateOrderRefund(ctx context.Context, refund *point.OrderRefund) error {
+	query := `
+		UPDATE point_order_refunds
+		SET refunded_payment_amount = ?, restored_point_amount = ?, clawed_back_point_amount = ?, updated_at = ?
+		WHERE id = ?
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	_, err := db.ExecContext(ctx, query,
+		refund.RefundedPaymentAmount,
+		refund.RestoredPointAmount,
+		refund.ClawedBackPointAmount,
+		time.Now(),
+		refund.ID,
+	)
+	return err
+}
@@ -4,9 +4,14 @@ import (
 	"context"
 	"database/sql"
 	"shopping-mall/internal/domain/point"
+	"strings"
 	"time"
 )
 
+// transactionColumns point_transactions 조회 컬럼 목록
+const transactionColumns = `id, user_id, transaction_type, amount, remaining_amount, balance_after, reason_type, reason_detail,
+		       operator_id, ticket_ref, campaign_id, wallet, order_id, earned_at, scheduled_at, expires_at, expired, status, created_at`
+
 // PointRepository 포인트 리포지토리 구현
 type PointRepository struct {
 	tm *TransactionManager
@@ -17,44 +22,52 @@ func NewPointRepository(tm *TransactionManager) *PointRepository {
 	return &PointRepository{tm: tm}
 }
 
-// GetUserPoint 사용자 포인트 조회 (락 포함)
-func (r *PointRepository) GetUserPoint(ctx context.Context, userID int64) (*point.UserPoint, error) {
+// userPointColumns user_points 조회 컬럼
+const userPointColumns = `user_id, available_balance, pending_balance, held_balance, debt_balance, total_earned, total_used, updated_at`
+
+// GetUserPointForUpdate 사용자 포인트 조회 (FOR UPDATE 락 포함, 트랜잭션 내에서 사용)
+func (r *PointRepository) GetUserPointForUpdate(ctx context.Context, userID int64) (*point.UserPoint, error) {
 	query := `
-		SELECT user_id, available_balance, pending_balance, total_earned, total_used, updated_at
+		SELECT ` + userPointColumns + `
 		FROM user_points
 		WHERE user_id = ?
 		FOR UPDATE
 	`
 
 	db := r.tm.GetDBOrTx(ctx)
-	row := db.QueryRowContext(ctx, query, userID)
+	return r.getUserPoint(db.QueryRowContext(ctx, query, userID))
+}
 
-	var up point.UserPoint
-	var updatedAt time.Time
-	err := row.Scan(
-		&up.UserID,
-		&up.AvailableBalance,
-		&up.PendingBalance,
-		&up.TotalEarned,
-		&up.TotalUsed,
-		&updatedAt,
-	)
+// GetUserPoint 사용자 포인트 조회 (락 없음, 조회 전용)
+// 조회 결과가 잔액 캐시에 저장되므로 복제 지연이 없는 primary 에서 조회
+func (r *PointRepository) GetUserPoint(ctx context.Context, userID int64) (*point.UserPoint, error) {
+	query := `
+		SELECT ` + userPointColumns + `
+		FROM user_points
+		WHERE user_id = ?
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	return r.getUserPoint(db.QueryRowContext(ctx, query, userID))
+}
+
+// getUserPoint 사용자 포인트 단건 스캔
+func (r *PointRepository) getUserPoint(row *sql.Row) (*point.UserPoint, error) {
+	up, err := scanUserPoint(row)
 	if err == sql.ErrNoRows {
 		return nil, point.ErrPointNotFound
 	}
 	if err != nil {
 		return nil, err
 	}
-
-	up.UpdatedAt = updatedAt
-	return &up, nil
+	return up, nil
 }
 
 // CreateUserPoint 사용자 포인트 생성
 func (r *PointRepository) CreateUserPoint(ctx context.Context, userPoint *point.UserPoint) error {
 	query := `
-		INSERT INTO user_points (user_id, available_balance, pending_balance, total_earned, total_used, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?)
+		INSERT INTO user_points (user_id, available_balance, pending_balance, held_balance, debt_balance, total_earned, total_used, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
 	db := r.tm.GetDBOrTx(ctx)
@@ -62,6 +75,8 @@ func (r *PointRepository) CreateUserPoint(ctx context.Context, userPoint *point.
 		userPoint.UserID,
 		userPoint.AvailableBalance,
 		userPoint.PendingBalance,
+		userPoint.HeldBalance,
+		userPoint.DebtBalance,
 		userPoint.TotalEarned,
 		userPoint.TotalUsed,
 		time.Now(),
@@ -73,7 +88,7 @@ func (r *PointRepository) CreateUserPoint(ctx context.Context, userPoint *point.
 func (r *PointRepository) UpdateUserPoint(ctx context.Context, userPoint *point.UserPoint) error {
 	query := `
 		UPDATE user_points
-		SET available_balance = ?, pending_balance = ?, total_earned = ?, total_used = ?, updated_at = ?
+		SET available_balance = ?, pending_balance = ?, held_balance = ?, debt_balance = ?, total_earned = ?, total_used = ?, updated_at = ?
 		WHERE user_id = ?
 	`
 
@@ -81,6 +96,8 @@ func (r *PointRepository) UpdateUserPoint(ctx context.Context, userPoint *point.
 	_, err := db.ExecContext(ctx, query,
 		userPoint.AvailableBalance,
 		userPoint.PendingBalance,
+		userPoint.HeldBalance,
+		userPoint.DebtBalance,
 		userPoint.TotalEarned,
 		userPoint.TotalUsed,
 		time.Now(),
@@ -89,25 +106,81 @@ func (r *PointRepository) UpdateUserPoint(ctx context.Context, userPoint *point.
 	return err
 }
 
+// GetUsersWithDebt 포인트 부채가 있는 사용자 조회 (부채 큰 순)
+func (r *PointRepository) GetUsersWithDebt(ctx context.Context, limit, offset int) ([]*point.UserPoint, error) {
+	query := `
+		SELECT ` + userPointColumns + `
+		FROM user_points
+		WHERE debt_balance > 0
+		ORDER BY debt_balance DESC, user_id ASC
+		LIMIT ? OFFSET ?
+	`
+
+	db := r.tm.GetReadDBOrTx(ctx)
+	rows, err := db.QueryContext(ctx, query, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var userPoints []*point.UserPoint
+	for rows.Next() {
+		up, err := scanUserPoint(rows)
+		if err != nil {
+			return nil, err
+		}
+		userPoints = append(userPoints, up)
+	}
+
+	return userPoints, rows.Err()
+}
+
+// GetDebtSummary 포인트 부채 집계 (부채 사용자 수, 총 부채)
+func (r *PointRepository) GetDebtSummary(ctx context.Context) (int64, int64, error) {
+	query := `
+		SELECT COUNT(*), COALESCE(SUM(debt_balance), 0)
+		FROM user_points
+		WHERE debt_balance > 0
+	`
+
+	db := r.tm.GetReadDBOrTx(ctx)
+	var userCount, totalDebt int64
+	if err := db.QueryRowContext(ctx, query).Scan(&userCount, &totalDebt); err != nil {
+		return 0, 0, err
+	}
+	return userCount, totalDebt, nil
+}
+
 // CreateTransaction 거래 내역 생성
 func (r *PointRepository) CreateTransaction(ctx context.Context, tx *point.Transaction) error {
 	query := `
-		INSERT INTO point_transactions 
-		(user_id, transaction_type, amount, balance_after, reason_type, reason_detail, 
-		 order_id, earned_at, expires_at, expired, status, created_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		INSERT INTO point_transactions
+		(user_id, transaction_type, amount, remaining_amount, balance_after, reason_type, reason_detail,
+		 operator_id, ticket_ref, campaign_id, wallet, order_id, earned_at, scheduled_at, expires_at, expired, status, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
+	// 적립 lot 은 항상 지갑에 속함 (지정하지 않으면 일반 포인트)
+	if tx.Type == point.TransactionTypeEarn {
+		tx.Wallet = tx.Wallet.OrDefault()
+	}
+
 	db := r.tm.GetDBOrTx(ctx)
 	result, err := db.ExecContext(ctx, query,
 		tx.UserID,
 		tx.Type,
 		tx.Amount,
+		tx.RemainingAmount,
 		tx.BalanceAfter,
 		tx.ReasonType,
 		tx.ReasonDetail,
+		nullString(tx.OperatorID),
+		nullString(tx.TicketRef),
+		tx.CampaignID,
+		nullString(string(tx.Wallet)),
 		tx.OrderID,
 		tx.EarnedAt,
+		tx.ScheduledAt,
 		tx.ExpiresAt,
 		tx.Expired,
 		tx.Status,
@@ -126,93 +199,115 @@ func (r *PointRepository) CreateTransaction(ctx context.Context, tx *point.Trans
 	return nil
 }
 
-// GetEarnedTransactions 적립 거래 내역 조회 (FIFO용, 만료일 순)
-func (r *PointRepository) GetEarnedTransactions(ctx context.Context, userID int64, limit int) ([]*point.Transaction, error) {
+// GetEarnedTransactions 잔여 포인트가 있는 적립 거래 내역 조회 (지갑 순서, 지갑 내에서는 만료일 순)
+// 만료일이 지났지만 아직 만료 배치가 처리하지 않은 lot 은 사용/차감 대상에서 제외
+// 한 사용자의 lot 을 여러 페이지로 나눠 읽으므로 id 를 마지막 정렬 기준으로 두어 페이지 사이 순서를 고정
+func (r *PointRepository) GetEarnedTransactions(ctx context.Context, userID int64, walletOrder []point.Wallet, limit, offset int) ([]*point.Transaction, error) {
+	// 지갑 순서 (목록에 없는 지갑은 FIELD 가 0 이므로 가장 먼저 차감)
+	orderBy := ""
+	args := []interface{}{userID, time.Now()}
+	if len(walletOrder) > 0 {
+		orderBy = "FIELD(wallet" + strings.Repeat(", ?", len(walletOrder)) + "), "
+		for _, wallet := range walletOrder {
+			args = append(args, wallet)
+		}
+	}
+	args = append(args, limit, offset)
+
 	query := `
-		SELECT id, user_id, transaction_type, amount, balance_after, reason_type, reason_detail,
-		       order_id, earned_at, expires_at, expired, status, created_at
+		SELECT ` + transactionColumns + `
 		FROM point_transactions
-		WHERE user_id = ? 
+		WHERE user_id = ?
 		  AND transaction_type = 'EARN'
 		  AND expired = false
 		  AND status = 'CONFIRMED'
-		ORDER BY expires_at ASC, created_at ASC
-		LIMIT ?
+		  AND remaining_amount > 0
+		  AND (expires_at IS NULL OR expires_at > ?)
+		ORDER BY ` + orderBy + `expires_at IS NULL, expires_at ASC, created_at ASC, id ASC
+		LIMIT ? OFFSET ?
+		FOR UPDATE
 	`
 
 	db := r.tm.GetDBOrTx(ctx)
-	rows, err := db.QueryContext(ctx, query, userID, limit)
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return scanTransactions(rows)
+}
+
+// GetWalletBalances 지갑별 사용 가능 포인트 조회 (만료되지 않은 확정 적립 lot 의 잔여 포인트 합계)
+// 잔액 캐시에 함께 저장되므로 복제 지연이 없는 primary 에서 조회
+func (r *PointRepository) GetWalletBalances(ctx context.Context, userID int64) (map[point.Wallet]int64, error) {
+	query := `
+		SELECT wallet, COALESCE(SUM(remaining_amount), 0)
+		FROM point_transactions
+		WHERE user_id = ?
+		  AND transaction_type = 'EARN'
+		  AND expired = false
+		  AND status = 'CONFIRMED'
+		  AND remaining_amount > 0
+		GROUP BY wallet
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	rows, err := db.QueryContext(ctx, query, userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var transactions []*point.Transaction
+	balances := make(map[point.Wallet]int64, len(point.Wallets))
+	for _, wallet := range point.Wallets {
+		balances[wallet] = 0
+	}
 	for rows.Next() {
-		var tx point.Transaction
-		var earnedAt, expiresAt sql.NullTime
-		var orderID sql.NullInt64
-
-		err := rows.Scan(
-			&tx.ID,
-			&tx.UserID,
-			&tx.Type,
-			&tx.Amount,
-			&tx.BalanceAfter,
-			&tx.ReasonType,
-			&tx.ReasonDetail,
-			&orderID,
-			&earnedAt,
-			&expiresAt,
-			&tx.Expired,
-			&tx.Status,
-			&tx.CreatedAt,
-		)
-		if err != nil {
+		var wallet sql.NullString
+		var amount int64
+		if err := rows.Scan(&wallet, &amount); err != nil {
 			return nil, err
 		}
-
-		if orderID.Valid {
-			tx.OrderID = &orderID.Int64
-		}
-		if earnedAt.Valid {
-			tx.EarnedAt = &earnedAt.Time
-		}
-		if expiresAt.Valid {
-			tx.ExpiresAt = &expiresAt.Time
-		}
-
-		transactions = append(transactions, &tx)
+		balances[point.Wallet(wallet.String).OrDefault()] += amount
 	}
 
-	return transactions, rows.Err()
+	return balances, rows.Err()
 }
 
 // UpdateTransaction 거래 내역 업데이트
 func (r *PointRepository) UpdateTransaction(ctx context.Context, tx *point.Transaction) error {
 	query := `
 		UPDATE point_transactions
-		SET expired = ?, status = ?
+		SET remaining_amount = ?, balance_after = ?, earned_at = ?, expires_at = ?, expired = ?, status = ?
 		WHERE id = ?
 	`
 
 	db := r.tm.GetDBOrTx(ctx)
-	_, err := db.ExecContext(ctx, query, tx.Expired, tx.Status, tx.ID)
+	_, err := db.ExecContext(ctx, query,
+		tx.RemainingAmount,
+		tx.BalanceAfter,
+		tx.EarnedAt,
+		tx.ExpiresAt,
+		tx.Expired,
+		tx.Status,
+		tx.ID,
+	)
 	return err
 }
 
-// GetExpiringTransactions 만료 예정 거래 내역 조회
-func (r *PointRepository) GetExpiringTransactions(ctx context.Context, before time.Time, limit int) ([]*point.Transaction, error) {
+// GetExpiringUserIDs 만료 대상 적립 lot 이 있는 사용자 ID 조회 (가장 먼저 만료되는 사용자 순)
+// 락 없이 대상 사용자만 찾으며, lot 은 사용자 락 획득 후 GetExpiringTransactionsForUpdate 로 다시 조회
+func (r *PointRepository) GetExpiringUserIDs(ctx context.Context, before time.Time, limit int) ([]int64, error) {
 	query := `
-		SELECT id, user_id, transaction_type, amount, balance_after, reason_type, reason_detail,
-		       order_id, earned_at, expires_at, expired, status, created_at
+		SELECT user_id
 		FROM point_transactions
 		WHERE transaction_type = 'EARN'
 		  AND expired = false
 		  AND status = 'CONFIRMED'
+		  AND remaining_amount > 0
 		  AND expires_at IS NOT NULL
 		  AND expires_at <= ?
-		ORDER BY expires_at ASC
+		GROUP BY user_id
+		ORDER BY MIN(expires_at) ASC
 		LIMIT ?
 	`
 
@@ -223,150 +318,307 @@ func (r *PointRepository) GetExpiringTransactions(ctx context.Context, before ti
 	}
 	defer rows.Close()
 
-	var transactions []*point.Transaction
+	var userIDs []int64
 	for rows.Next() {
-		var tx point.Transaction
-		var earnedAt, expiresAt sql.NullTime
-		var orderID sql.NullInt64
-
-		err := rows.Scan(
-			&tx.ID,
-			&tx.UserID,
-			&tx.Type,
-			&tx.Amount,
-			&tx.BalanceAfter,
-			&tx.ReasonType,
-			&tx.ReasonDetail,
-			&orderID,
-			&earnedAt,
-			&expiresAt,
-			&tx.Expired,
-			&tx.Status,
-			&tx.CreatedAt,
-		)
-		if err != nil {
+		var userID int64
+		if err := rows.Scan(&userID); err != nil {
 			return nil, err
 		}
+		userIDs = append(userIDs, userID)
+	}
+	return userIDs, rows.Err()
+}
 
-		if orderID.Valid {
-			tx.OrderID = &orderID.Int64
-		}
-		if earnedAt.Valid {
-			tx.EarnedAt = &earnedAt.Time
-		}
-		if expiresAt.Valid {
-			tx.ExpiresAt = &expiresAt.Time
-		}
+// GetExpiringTransactionsForUpdate 사용자의 만료 대상 적립 lot 조회 (FOR UPDATE 락)
+// 사용자 포인트 락을 잡은 뒤 조회하므로 동시에 사용/회수된 잔여 포인트가 반영된 최신 값을 읽음
+func (r *PointRepository) GetExpiringTransactionsForUpdate(ctx context.Context, userID int64, before time.Time) ([]*point.Transaction, error) {
+	query := `
+		SELECT ` + transactionColumns + `
+		FROM point_transactions
+		WHERE user_id = ?
+		  AND transaction_type = 'EARN'
+		  AND expired = false
+		  AND status = 'CONFIRMED'
+		  AND remaining_amount > 0
+		  AND expires_at IS NOT NULL
+		  AND expires_at <= ?
+		ORDER BY expires_at ASC
+		FOR UPDATE
+	`
 
-		transactions = append(transactions, &tx)
+	db := r.tm.GetDBOrTx(ctx)
+	rows, err := db.QueryContext(ctx, query, userID, before)
+	if err != nil {
+		return nil, err
 	}
-
-	return transactions, rows.Err()
+	return scanTransactions(rows)
 }
 
-// GetTransactionsByUser 사용자 거래 내역 조회
-func (r *PointRepository) GetTransactionsByUser(ctx context.Context, userID int64, limit, offset int) ([]*point.Transaction, error) {
+// GetDuePendingUserIDs 적립 확정 예정일이 지난 적립 예정 거래가 있는 사용자 ID 조회 (가장 먼저 확정 예정인 사용자 순)
+func (r *PointRepository) GetDuePendingUserIDs(ctx context.Context, before time.Time, limit int) ([]int64, error) {
 	query := `
-		SELECT id, user_id, transaction_type, amount, balance_after, reason_type, reason_detail,
-		       order_id, earned_at, expires_at, expired, status, created_at
+		SELECT user_id
 		FROM point_transactions
-		WHERE user_id = ?
-		ORDER BY created_at DESC
-		LIMIT ? OFFSET ?
+		WHERE transaction_type = 'EARN'
+		  AND status = 'PENDING'
+		  AND scheduled_at IS NOT NULL
+		  AND scheduled_at <= ?
+		GROUP BY user_id
+		ORDER BY MIN(scheduled_at) ASC
+		LIMIT ?
 	`
 
 	db := r.tm.GetDBOrTx(ctx)
-	rows, err := db.QueryContext(ctx, query, userID, limit, offset)
+	rows, err := db.QueryContext(ctx, query, before, limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var transactions []*point.Transaction
+	var userIDs []int64
 	for rows.Next() {
-		var tx point.Transaction
-		var earnedAt, expiresAt sql.NullTime
-		var orderID sql.NullInt64
-
-		err := rows.Scan(
-			&tx.ID,
-			&tx.UserID,
-			&tx.Type,
-			&tx.Amount,
-			&tx.BalanceAfter,
-			&tx.ReasonType,
-			&tx.ReasonDetail,
-			&orderID,
-			&earnedAt,
-			&expiresAt,
-			&tx.Expired,
-			&tx.Status,
-			&tx.CreatedAt,
-		)
-		if err != nil {
+		var userID int64
+		if err := rows.Scan(&userID); err != nil {
 			return nil, err
 		}
+		userIDs = append(userIDs, userID)
+	}
+	return userIDs, rows.Err()
+}
 
-		if orderID.Valid {
-			tx.OrderID = &orderID.Int64
-		}
-		if earnedAt.Valid {
-			tx.EarnedAt = &earnedAt.Time
-		}
-		if expiresAt.Valid {
-			tx.ExpiresAt = &expiresAt.Time
-		}
+// GetDuePendingTransactionsForUpdate 사용자의 적립 확정 예정일이 지난 적립 예정 거래 내역 조회 (FOR UPDATE 락)
+// 사용자 포인트 락을 잡은 뒤 조회하므로 동시에 취소/감액된 적립 예정 거래가 반영된 최신 값을 읽음
+func (r *PointRepository) GetDuePendingTransactionsForUpdate(ctx context.Context, userID int64, before time.Time) ([]*point.Transaction, error) {
+	query := `
+		SELECT ` + transactionColumns + `
+		FROM point_transactions
+		WHERE user_id = ?
+		  AND transaction_type = 'EARN'
+		  AND status = 'PENDING'
+		  AND scheduled_at IS NOT NULL
+		  AND scheduled_at <= ?
+		ORDER BY scheduled_at ASC
+		FOR UPDATE
+	`
 
-		transactions = append(transactions, &tx)
+	db := r.tm.GetDBOrTx(ctx)
+	rows, err := db.QueryContext(ctx, query, userID, before)
+	if err != nil {
+		return nil, err
 	}
+	return scanTransactions(rows)
+}
 
-	return transactions, rows.Err()
+// GetTransactionsByUser 사용자 거래 내역 조회
+func (r *PointRepository) GetTransactionsByUser(ctx context.Context, userID int64, limit, offset int) ([]*point.Transaction, error) {
+	query := `
+		SELECT ` + transactionColumns + `
+		FROM point_transactions
+		WHERE user_id = ?
+		ORDER BY created_at DESC
+		LIMIT ? OFFSET ?
+	`
+
+	db := r.tm.GetReadDBOrTx(ctx)
+	rows, err := db.QueryContext(ctx, query, userID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return scanTransactions(rows)
 }
 
 // GetTransactionByID 거래 내역 ID로 조회
 func (r *PointRepository) GetTransactionByID(ctx context.Context, id int64) (*point.Transaction, error) {
 	query := `
-		SELECT id, user_id, transaction_type, amount, balance_after, reason_type, reason_detail,
-		       order_id, earned_at, expires_at, expired, status, created_at
+		SELECT ` + transactionColumns + `
+		FROM point_transactions
+		WHERE id = ?
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	tx, err := scanTransaction(db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, point.ErrTransactionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// GetTransactionsByOrderID 주문 ID로 거래 내역 조회
+func (r *PointRepository) GetTransactionsByOrderID(ctx context.Context, orderID int64) ([]*point.Transaction, error) {
+	query := `
+		SELECT ` + transactionColumns + `
 		FROM point_transactions
+		WHERE order_id = ?
+		ORDER BY created_at ASC
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	rows, err := db.QueryContext(ctx, query, orderID)
+	if err != nil {
+		return nil, err
+	}
+	return scanTransactions(rows)
+}
+
+// CreateAllocation 적립 lot 차감 내역 생성
+func (r *PointRepository) CreateAllocation(ctx context.Context, allocation *point.Allocation) error {
+	query := `
+		INSERT INTO point_allocations
+		(user_id, use_transaction_id, earn_transaction_id, amount, restored_amount, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	result, err := db.ExecContext(ctx, query,
+		allocation.UserID,
+		allocation.UseTransactionID,
+		allocation.EarnTransactionID,
+		allocation.Amount,
+		allocation.RestoredAmount,
+		time.Now(),
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	allocation.ID = id
+	return nil
+}
+
+// UpdateAllocation 적립 lot 차감 내역 업데이트
+func (r *PointRepository) UpdateAllocation(ctx context.Context, allocation *point.Allocation) error {
+	query := `
+		UPDATE point_allocations
+		SET restored_amount = ?
 		WHERE id = ?
 	`
 
 	db := r.tm.GetDBOrTx(ctx)
-	row := db.QueryRowContext(ctx, query, id)
+	_, err := db.ExecContext(ctx, query, allocation.RestoredAmount, allocation.ID)
+	return err
+}
+
+// GetAllocationsByUseTransactionID 사용 거래의 적립 lot 차감 내역 조회
+func (r *PointRepository) GetAllocationsByUseTransactionID(ctx context.Context, useTransactionID int64) ([]*point.Allocation, error) {
+	query := `
+		SELECT id, user_id, use_transaction_id, earn_transaction_id, amount, restored_amount, created_at
+		FROM point_allocations
+		WHERE use_transaction_id = ?
+		ORDER BY id ASC
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	rows, err := db.QueryContext(ctx, query, useTransactionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var allocations []*point.Allocation
+	for rows.Next() {
+		var a point.Allocation
+		if err := rows.Scan(
+			&a.ID,
+			&a.UserID,
+			&a.UseTransactionID,
+			&a.EarnTransactionID,
+			&a.Amount,
+			&a.RestoredAmount,
+			&a.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		allocations = append(allocations, &a)
+	}
+
+	return allocations, rows.Err()
+}
+
+// rowScanner *sql.Row, *sql.Rows 공통 인터페이스
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// nullString 빈 문자열은 NULL 로 저장
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+// scanUserPoint userPointColumns 순서로 사용자 포인트 스캔
+func scanUserPoint(s rowScanner) (*point.UserPoint, error) {
+	var up point.UserPoint
+	err := s.Scan(
+		&up.UserID,
+		&up.AvailableBalance,
+		&up.PendingBalance,
+		&up.HeldBalance,
+		&up.DebtBalance,
+		&up.TotalEarned,
+		&up.TotalUsed,
+		&up.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &up, nil
+}
 
+// scanTransaction transactionColumns 순서로 거래 내역 스캔
+func scanTransaction(s rowScanner) (*point.Transaction, error) {
 	var tx point.Transaction
-	var earnedAt, expiresAt sql.NullTime
-	var orderID sql.NullInt64
+	var earnedAt, scheduledAt, expiresAt sql.NullTime
+	var orderID, remainingAmount, campaignID sql.NullInt64
+	var operatorID, ticketRef, wallet sql.NullString
 
-	err := row.Scan(
+	err := s.Scan(
 		&tx.ID,
 		&tx.UserID,
 		&tx.Type,
 		&tx.Amount,
+		&remainingAmount,
 		&tx.BalanceAfter,
 		&tx.ReasonType,
 		&tx.ReasonDetail,
+		&operatorID,
+		&ticketRef,
+		&campaignID,
+		&wallet,
 		&orderID,
 		&earnedAt,
+		&scheduledAt,
 		&expiresAt,
 		&tx.Expired,
 		&tx.Status,
 		&tx.CreatedAt,
 	)
-	if err == sql.ErrNoRows {
-		return nil, point.ErrTransactionNotFound
-	}
 	if err != nil {
 		return nil, err
 	}
 
+	tx.RemainingAmount = remainingAmount.Int64
+	tx.OperatorID = operatorID.String
+	tx.TicketRef = ticketRef.String
+	tx.Wallet = point.Wallet(wallet.String)
+	if campaignID.Valid {
+		tx.CampaignID = &campaignID.Int64
+	}
 	if orderID.Valid {
 		tx.OrderID = &orderID.Int64
 	}
 	if earnedAt.Valid {
 		tx.EarnedAt = &earnedAt.Time
 	}
+	if scheduledAt.Valid {
+		tx.ScheduledAt = &scheduledAt.Time
+	}
 	if expiresAt.Valid {
 		tx.ExpiresAt = &expiresAt.Time
 	}
@@ -374,59 +626,17 @@ func (r *PointRepository) GetTransactionByID(ctx context.Context, id int64) (*po
 	return &tx, nil
 }
 
-// GetTransactionsByOrderID 주문 ID로 거래 내역 조회
-func (r *PointRepository) GetTransactionsByOrderID(ctx context.Context, orderID int64) ([]*point.Transaction, error) {
-	query := `
-		SELECT id, user_id, transaction_type, amount, balance_after, reason_type, reason_detail,
-		       order_id, earned_at, expires_at, expired, status, created_at
-		FROM point_transactions
-		WHERE order_id = ?
-		ORDER BY created_at ASC
-	`
-
-	db := r.tm.GetDBOrTx(ctx)
-	rows, err := db.QueryContext(ctx, query, orderID)
-	if err != nil {
-		return nil, err
-	}
+// scanTransactions 거래 내역 목록 스캔 (rows 는 내부에서 닫음)
+func scanTransactions(rows *sql.Rows) ([]*point.Transaction, error) {
 	defer rows.Close()
 
 	var transactions []*point.Transaction
 	for rows.Next() {
-		var tx point.Transaction
-		var earnedAt, expiresAt sql.NullTime
-		var orderIDVal sql.NullInt64
-
-		err := rows.Scan(
-			&tx.ID,
-			&tx.UserID,
-			&tx.Type,
-			&tx.Amount,
-			&tx.BalanceAfter,
-			&tx.ReasonType,
-			&tx.ReasonDetail,
-			&orderIDVal,
-			&earnedAt,
-			&expiresAt,
-			&tx.Expired,
-			&tx.Status,
-			&tx.CreatedAt,
-		)
+		tx, err := scanTransaction(rows)
 		if err != nil {
 			return nil, err
 		}
-
-		if orderIDVal.Valid {
-			tx.OrderID = &orderIDVal.Int64
-		}
-		if earnedAt.Valid {
-			tx.EarnedAt = &earnedAt.Time
-		}
-		if expiresAt.Valid {
-			tx.ExpiresAt = &expiresAt.Time
-		}
-
-		transactions = append(transactions, &tx)
+		transactions = append(transactions, tx)
 	}
 
 	return transactions, rows.Err()
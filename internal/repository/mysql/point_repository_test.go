@@ -0,0 +1,88 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"shopping-mall/internal/domain/point"
+	"shopping-mall/internal/infrastructure/database"
+)
+
+// errRollback 테스트 데이터를 남기지 않도록 트랜잭션을 롤백시키는 오류
+var errRollback = errors.New("rollback test transaction")
+
+// openTestDB TEST_MYSQL_DSN 의 데이터베이스에 마이그레이션을 적용하고 연결 (설정되지 않으면 건너뜀)
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	dsn := os.Getenv("TEST_MYSQL_DSN")
+	if dsn == "" {
+		t.Skip("TEST_MYSQL_DSN is not set")
+	}
+
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		t.Fatalf("open test database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	if _, err := database.NewMigrator(db, "../../../migrations").Up(context.Background()); err != nil {
+		t.Fatalf("migrate test database: %v", err)
+	}
+	return db
+}
+
+func TestGetEarnedTransactionsExcludesPastDueLots(t *testing.T) {
+	tm := NewTransactionManager(openTestDB(t))
+	repo := NewPointRepository(tm)
+	const userID = 900000001
+
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(24 * time.Hour)
+
+	err := tm.WithTransaction(context.Background(), func(txCtx context.Context) error {
+		if err := repo.CreateUserPoint(txCtx, &point.UserPoint{UserID: userID, AvailableBalance: 300}); err != nil {
+			return err
+		}
+
+		lots := map[string]*time.Time{"past due": &past, "valid": &future, "no expiry": nil}
+		ids := make(map[int64]string, len(lots))
+		for name, expiresAt := range lots {
+			lot := &point.Transaction{
+				UserID:          userID,
+				Type:            point.TransactionTypeEarn,
+				Amount:          100,
+				RemainingAmount: 100,
+				ReasonType:      point.ReasonTypeAdmin,
+				ReasonDetail:    name,
+				ExpiresAt:       expiresAt,
+				Status:          point.TransactionStatusConfirmed,
+			}
+			if err := repo.CreateTransaction(txCtx, lot); err != nil {
+				return err
+			}
+			ids[lot.ID] = name
+		}
+
+		earned, err := repo.GetEarnedTransactions(txCtx, userID, nil, 10, 0)
+		if err != nil {
+			return err
+		}
+		if len(earned) != 2 {
+			t.Errorf("GetEarnedTransactions() returned %d lots, want 2", len(earned))
+		}
+		for _, lot := range earned {
+			if ids[lot.ID] == "past due" {
+				t.Errorf("GetEarnedTransactions() returned past-due lot %d", lot.ID)
+			}
+		}
+		return errRollback
+	})
+	if !errors.Is(err, errRollback) {
+		t.Fatalf("WithTransaction() error = %v", err)
+	}
+}
@@ -0,0 +1,131 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"shopping-mall/internal/domain/point"
+	"time"
+)
+
+// reservationColumns point_reservations 조회 컬럼 목록
+const reservationColumns = `id, user_id, order_id, use_transaction_id, amount, order_amount, status, expires_at, created_at, updated_at`
+
+// CreateReservation 포인트 예약 생성
+func (r *PointRepository) CreateReservation(ctx context.Context, reservation *point.Reservation) error {
+	query := `
+		INSERT INTO point_reservations
+		(user_id, order_id, use_transaction_id, amount, order_amount, status, expires_at, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	now := time.Now()
+	db := r.tm.GetDBOrTx(ctx)
+	result, err := db.ExecContext(ctx, query,
+		reservation.UserID,
+		reservation.OrderID,
+		reservation.UseTransactionID,
+		reservation.Amount,
+		reservation.OrderAmount,
+		reservation.Status,
+		reservation.ExpiresAt,
+		now,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	reservation.ID = id
+	return nil
+}
+
+// UpdateReservation 포인트 예약 업데이트
+func (r *PointRepository) UpdateReservation(ctx context.Context, reservation *point.Reservation) error {
+	query := `
+		UPDATE point_reservations
+		SET status = ?, updated_at = ?
+		WHERE id = ?
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	_, err := db.ExecContext(ctx, query, reservation.Status, time.Now(), reservation.ID)
+	return err
+}
+
+// GetHeldReservationByOrderID 주문의 진행 중인 포인트 예약 조회 (락 포함)
+func (r *PointRepository) GetHeldReservationByOrderID(ctx context.Context, orderID int64) (*point.Reservation, error) {
+	query := `
+		SELECT ` + reservationColumns + `
+		FROM point_reservations
+		WHERE order_id = ? AND status = 'HELD'
+		ORDER BY id DESC
+		LIMIT 1
+		FOR UPDATE
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	reservation, err := scanReservation(db.QueryRowContext(ctx, query, orderID))
+	if err == sql.ErrNoRows {
+		return nil, point.ErrReservationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return reservation, nil
+}
+
+// GetExpiredReservations 유효시간이 지난 진행 중 예약 조회
+func (r *PointRepository) GetExpiredReservations(ctx context.Context, before time.Time, limit int) ([]*point.Reservation, error) {
+	query := `
+		SELECT ` + reservationColumns + `
+		FROM point_reservations
+		WHERE status = 'HELD'
+		  AND expires_at <= ?
+		ORDER BY expires_at ASC
+		LIMIT ?
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	rows, err := db.QueryContext(ctx, query, before, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reservations []*point.Reservation
+	for rows.Next() {
+		reservation, err := scanReservation(rows)
+		if err != nil {
+			return nil, err
+		}
+		reservations = append(reservations, reservation)
+	}
+
+	return reservations, rows.Err()
+}
+
+// scanReservation reservationColumns 순서로 포인트 예약 스캔
+func scanReservation(s rowScanner) (*point.Reservation, error) {
+	var reservation point.Reservation
+	err := s.Scan(
+		&reservation.ID,
+		&reservation.UserID,
+		&reservation.OrderID,
+		&reservation.UseTransactionID,
+		&reservation.Amount,
+		&reservation.OrderAmount,
+		&reservation.Status,
+		&reservation.ExpiresAt,
+		&reservation.CreatedAt,
+		&reservation.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &reservation, nil
+}
@@ -0,0 +1,202 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"shopping-mall/internal/domain/point"
+	"time"
+)
+
+// userTierColumns user_tiers 조회 컬럼 목록
+const userTierColumns = `user_id, tier, spend_amount, downgrade_at, evaluated_at, updated_at`
+
+// GetUserTier 사용자 회원 등급 조회 (등급이 없으면 기본 등급)
+func (r *PointRepository) GetUserTier(ctx context.Context, userID int64) (*point.UserTier, error) {
+	query := `
+		SELECT ` + userTierColumns + `
+		FROM user_tiers
+		WHERE user_id = ?
+	`
+	return r.getUserTier(ctx, query, userID)
+}
+
+// GetUserTierForUpdate 사용자 회원 등급 조회 (FOR UPDATE 락 포함, 등급이 없으면 기본 등급)
+func (r *PointRepository) GetUserTierForUpdate(ctx context.Context, userID int64) (*point.UserTier, error) {
+	query := `
+		SELECT ` + userTierColumns + `
+		FROM user_tiers
+		WHERE user_id = ?
+		FOR UPDATE
+	`
+	return r.getUserTier(ctx, query, userID)
+}
+
+// getUserTier 사용자 회원 등급 조회 공통 처리
+func (r *PointRepository) getUserTier(ctx context.Context, query string, userID int64) (*point.UserTier, error) {
+	db := r.tm.GetDBOrTx(ctx)
+	userTier, err := scanUserTier(db.QueryRowContext(ctx, query, userID))
+	if err == sql.ErrNoRows {
+		return &point.UserTier{UserID: userID, Tier: point.DefaultTier}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return userTier, nil
+}
+
+// SaveUserTier 사용자 회원 등급 저장 (없으면 생성)
+func (r *PointRepository) SaveUserTier(ctx context.Context, userTier *point.UserTier) error {
+	query := `
+		INSERT INTO user_tiers (user_id, tier, spend_amount, downgrade_at, evaluated_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			tier = VALUES(tier),
+			spend_amount = VALUES(spend_amount),
+			downgrade_at = VALUES(downgrade_at),
+			evaluated_at = VALUES(evaluated_at),
+			updated_at = VALUES(updated_at)
+	`
+
+	now := time.Now()
+	db := r.tm.GetDBOrTx(ctx)
+	_, err := db.ExecContext(ctx, query,
+		userTier.UserID,
+		userTier.Tier,
+		userTier.SpendAmount,
+		userTier.DowngradeAt,
+		userTier.EvaluatedAt,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+
+	userTier.UpdatedAt = now
+	return nil
+}
+
+// CreateTierHistory 회원 등급 변경 이력 생성
+func (r *PointRepository) CreateTierHistory(ctx context.Context, history *point.TierHistory) error {
+	query := `
+		INSERT INTO user_tier_histories (user_id, from_tier, to_tier, spend_amount, bonus_transaction_id, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	result, err := db.ExecContext(ctx, query,
+		history.UserID,
+		history.FromTier,
+		history.ToTier,
+		history.SpendAmount,
+		history.BonusTransactionID,
+		history.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	history.ID = id
+	return nil
+}
+
+// HasTierBonus 해당 등급 승급 보너스를 받은 적이 있는지 확인
+func (r *PointRepository) HasTierBonus(ctx context.Context, userID int64, tier point.Tier) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM user_tier_histories
+			WHERE user_id = ? AND to_tier = ? AND bonus_transaction_id IS NOT NULL
+		)
+	`
+
+	var exists bool
+	db := r.tm.GetDBOrTx(ctx)
+	if err := db.QueryRowContext(ctx, query, userID, tier).Scan(&exists); err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+// GetPurchaseSpend since 이후 구매 확정된 주문의 결제 금액 합계 (부분 환불 금액 제외)
+func (r *PointRepository) GetPurchaseSpend(ctx context.Context, userID int64, since time.Time) (int64, error) {
+	query := `
+		SELECT COALESCE(SUM(o.payment_amount - COALESCE(r.refunded_payment_amount, 0)), 0)
+		FROM orders o
+		LEFT JOIN point_order_refunds r ON r.order_id = o.id
+		WHERE o.user_id = ?
+		  AND o.confirmed_at IS NOT NULL
+		  AND o.confirmed_at >= ?
+		  AND o.status NOT IN ('CANCELLED', 'REFUNDED')
+	`
+
+	var spend int64
+	db := r.tm.GetReadDBOrTx(ctx)
+	if err := db.QueryRowContext(ctx, query, userID, since).Scan(&spend); err != nil {
+		return 0, err
+	}
+	return spend, nil
+}
+
+// GetTierPolicy 회원 등급별 정책 재정의 조회 (없으면 재정의 없는 정책)
+func (r *PointRepository) GetTierPolicy(ctx context.Context, tier point.Tier) (*point.TierPolicy, error) {
+	query := `
+		SELECT earn_rate, max_earn_per_order, max_use_rate
+		FROM membership_tier_policies
+		WHERE tier = ?
+	`
+
+	var earnRate, maxUseRate sql.NullFloat64
+	var maxEarnPerOrder sql.NullInt64
+
+	db := r.tm.GetDBOrTx(ctx)
+	err := db.QueryRowContext(ctx, query, tier).Scan(&earnRate, &maxEarnPerOrder, &maxUseRate)
+	if err == sql.ErrNoRows {
+		return &point.TierPolicy{Tier: tier}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tierPolicy := &point.TierPolicy{Tier: tier}
+	if earnRate.Valid {
+		tierPolicy.EarnRate = &earnRate.Float64
+	}
+	if maxEarnPerOrder.Valid {
+		tierPolicy.MaxEarnPerOrder = &maxEarnPerOrder.Int64
+	}
+	if maxUseRate.Valid {
+		tierPolicy.MaxUseRate = &maxUseRate.Float64
+	}
+	return tierPolicy, nil
+}
+
+// scanUserTier userTierColumns 순서로 사용자 회원 등급 스캔
+func scanUserTier(s rowScanner) (*point.UserTier, error) {
+	var userTier point.UserTier
+	var downgradeAt, evaluatedAt sql.NullTime
+
+	err := s.Scan(
+		&userTier.UserID,
+		&userTier.Tier,
+		&userTier.SpendAmount,
+		&downgradeAt,
+		&evaluatedAt,
+		&userTier.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if downgradeAt.Valid {
+		userTier.DowngradeAt = &downgradeAt.Time
+	}
+	if evaluatedAt.Valid {
+		userTier.EvaluatedAt = &evaluatedAt.Time
+	}
+	return &userTier, nil
+}
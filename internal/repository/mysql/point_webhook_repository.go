@@ -0,0 +1,358 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"shopping-mall/internal/domain/point"
+	"time"
+)
+
+// webhookColumns point_webhook_subscriptions 조회 컬럼 목록
+const webhookColumns = `id, url, event_types, secret, description, active, created_at, updated_at`
+
+// webhookDeliveryColumns point_webhook_deliveries 조회 컬럼 목록
+const webhookDeliveryColumns = `id, subscription_id, event_id, event_type, payload, status, attempts, next_attempt_at,
+	last_status_code, last_error, delivered_at, created_at, updated_at`
+
+// maxWebhookErrorLength last_error 컬럼 최대 길이
+const maxWebhookErrorLength = 500
+
+// CreateWebhookSubscription 웹훅 구독 생성
+func (r *PointRepository) CreateWebhookSubscription(ctx context.Context, subscription *point.WebhookSubscription) error {
+	query := `
+		INSERT INTO point_webhook_subscriptions
+		(url, event_types, secret, description, active, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	eventTypes, err := jsonList(subscription.EventTypes)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	db := r.tm.GetDBOrTx(ctx)
+	result, err := db.ExecContext(ctx, query,
+		subscription.URL,
+		eventTypes,
+		subscription.Secret,
+		subscription.Description,
+		subscription.Active,
+		now,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	subscription.ID = id
+	subscription.CreatedAt = now
+	subscription.UpdatedAt = now
+	return nil
+}
+
+// UpdateWebhookSubscription 웹훅 구독 업데이트
+func (r *PointRepository) UpdateWebhookSubscription(ctx context.Context, subscription *point.WebhookSubscription) error {
+	query := `
+		UPDATE point_webhook_subscriptions
+		SET url = ?, event_types = ?, secret = ?, description = ?, active = ?, updated_at = ?
+		WHERE id = ?
+	`
+
+	eventTypes, err := jsonList(subscription.EventTypes)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	db := r.tm.GetDBOrTx(ctx)
+	_, err = db.ExecContext(ctx, query,
+		subscription.URL,
+		eventTypes,
+		subscription.Secret,
+		subscription.Description,
+		subscription.Active,
+		now,
+		subscription.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	subscription.UpdatedAt = now
+	return nil
+}
+
+// GetWebhookSubscription 웹훅 구독 조회
+func (r *PointRepository) GetWebhookSubscription(ctx context.Context, id int64) (*point.WebhookSubscription, error) {
+	query := `
+		SELECT ` + webhookColumns + `
+		FROM point_webhook_subscriptions
+		WHERE id = ?
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	subscription, err := scanWebhookSubscription(db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, point.ErrWebhookNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+// GetWebhookSubscriptions 웹훅 구독 목록 조회 (최신순)
+func (r *PointRepository) GetWebhookSubscriptions(ctx context.Context, limit, offset int) ([]*point.WebhookSubscription, error) {
+	query := `
+		SELECT ` + webhookColumns + `
+		FROM point_webhook_subscriptions
+		ORDER BY id DESC
+		LIMIT ? OFFSET ?
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	rows, err := db.QueryContext(ctx, query, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return scanWebhookSubscriptions(rows)
+}
+
+// GetActiveWebhookSubscriptions 활성 웹훅 구독 전체 조회
+func (r *PointRepository) GetActiveWebhookSubscriptions(ctx context.Context) ([]*point.WebhookSubscription, error) {
+	query := `
+		SELECT ` + webhookColumns + `
+		FROM point_webhook_subscriptions
+		WHERE active = TRUE
+		ORDER BY id ASC
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return scanWebhookSubscriptions(rows)
+}
+
+// CreateWebhookDelivery 웹훅 전송 내역 생성 (같은 구독/이벤트가 이미 있으면 무시)
+// 이벤트 발행이 재시도되어도 구독마다 한 번만 전송 내역을 만듦
+func (r *PointRepository) CreateWebhookDelivery(ctx context.Context, delivery *point.WebhookDelivery) error {
+	query := `
+		INSERT IGNORE INTO point_webhook_deliveries
+		(subscription_id, event_id, event_type, payload, status, attempts, next_attempt_at, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	result, err := db.ExecContext(ctx, query,
+		delivery.SubscriptionID,
+		delivery.EventID,
+		delivery.EventType,
+		string(delivery.Payload),
+		delivery.Status,
+		delivery.Attempts,
+		delivery.NextAttemptAt,
+		delivery.CreatedAt,
+		delivery.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	delivery.ID = id
+	delivery.UpdatedAt = delivery.CreatedAt
+	return nil
+}
+
+// UpdateWebhookDelivery 웹훅 전송 상태 업데이트
+func (r *PointRepository) UpdateWebhookDelivery(ctx context.Context, delivery *point.WebhookDelivery) error {
+	query := `
+		UPDATE point_webhook_deliveries
+		SET status = ?, attempts = ?, next_attempt_at = ?, last_status_code = ?, last_error = ?, delivered_at = ?, updated_at = ?
+		WHERE id = ?
+	`
+
+	lastError := delivery.LastError
+	if len(lastError) > maxWebhookErrorLength {
+		lastError = lastError[:maxWebhookErrorLength]
+	}
+
+	now := time.Now()
+	db := r.tm.GetDBOrTx(ctx)
+	_, err := db.ExecContext(ctx, query,
+		delivery.Status,
+		delivery.Attempts,
+		delivery.NextAttemptAt,
+		delivery.LastStatusCode,
+		nullString(lastError),
+		delivery.DeliveredAt,
+		now,
+		delivery.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	delivery.UpdatedAt = now
+	return nil
+}
+
+// GetWebhookDelivery 웹훅 전송 내역 조회
+func (r *PointRepository) GetWebhookDelivery(ctx context.Context, id int64) (*point.WebhookDelivery, error) {
+	query := `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM point_webhook_deliveries
+		WHERE id = ?
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	delivery, err := scanWebhookDelivery(db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, point.ErrWebhookDeliveryNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+// GetWebhookDeliveries 구독의 웹훅 전송 내역 조회 (status 가 비어 있으면 전체, 최신순)
+func (r *PointRepository) GetWebhookDeliveries(ctx context.Context, subscriptionID int64, status point.WebhookDeliveryStatus, limit, offset int) ([]*point.WebhookDelivery, error) {
+	query := `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM point_webhook_deliveries
+		WHERE subscription_id = ?
+		  AND (? = '' OR status = ?)
+		ORDER BY id DESC
+		LIMIT ? OFFSET ?
+	`
+
+	db := r.tm.GetReadDBOrTx(ctx)
+	rows, err := db.QueryContext(ctx, query, subscriptionID, status, status, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return scanWebhookDeliveries(rows)
+}
+
+// GetDueWebhookDeliveries now 시점에 전송할 웹훅 전송 내역 조회
+func (r *PointRepository) GetDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]*point.WebhookDelivery, error) {
+	query := `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM point_webhook_deliveries
+		WHERE status = 'PENDING'
+		  AND next_attempt_at <= ?
+		ORDER BY next_attempt_at ASC, id ASC
+		LIMIT ?
+	`
+
+	db := r.tm.GetDBOrTx(ctx)
+	rows, err := db.QueryContext(ctx, query, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanWebhookDeliveries(rows)
+}
+
+// scanWebhookSubscription webhookColumns 순서로 웹훅 구독 스캔
+func scanWebhookSubscription(s rowScanner) (*point.WebhookSubscription, error) {
+	var subscription point.WebhookSubscription
+	var eventTypes sql.NullString
+
+	err := s.Scan(
+		&subscription.ID,
+		&subscription.URL,
+		&eventTypes,
+		&subscription.Secret,
+		&subscription.Description,
+		&subscription.Active,
+		&subscription.CreatedAt,
+		&subscription.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := parseJSONList(eventTypes, &subscription.EventTypes); err != nil {
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+// scanWebhookSubscriptions 웹훅 구독 목록 스캔 (rows 는 내부에서 닫음)
+func scanWebhookSubscriptions(rows *sql.Rows) ([]*point.WebhookSubscription, error) {
+	defer rows.Close()
+
+	var subscriptions []*point.WebhookSubscription
+	for rows.Next() {
+		subscription, err := scanWebhookSubscription(rows)
+		if err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	return subscriptions, rows.Err()
+}
+
+// scanWebhookDelivery webhookDeliveryColumns 순서로 웹훅 전송 내역 스캔
+func scanWebhookDelivery(s rowScanner) (*point.WebhookDelivery, error) {
+	var delivery point.WebhookDelivery
+	var payload string
+	var lastError sql.NullString
+	var deliveredAt sql.NullTime
+
+	err := s.Scan(
+		&delivery.ID,
+		&delivery.SubscriptionID,
+		&delivery.EventID,
+		&delivery.EventType,
+		&payload,
+		&delivery.Status,
+		&delivery.Attempts,
+		&delivery.NextAttemptAt,
+		&delivery.LastStatusCode,
+		&lastError,
+		&deliveredAt,
+		&delivery.CreatedAt,
+		&delivery.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery.Payload = []byte(payload)
+	delivery.LastError = lastError.String
+	if deliveredAt.Valid {
+		delivery.DeliveredAt = &deliveredAt.Time
+	}
+	return &delivery, nil
+}
+
+// scanWebhookDeliveries 웹훅 전송 내역 목록 스캔 (rows 는 내부에서 닫음)
+func scanWebhookDeliveries(rows *sql.Rows) ([]*point.WebhookDelivery, error) {
+	defer rows.Close()
+
+	var deliveries []*point.WebhookDelivery
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, rows.Err()
+}
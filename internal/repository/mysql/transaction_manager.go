@@ -3,27 +3,212 @@ package mysql
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
 )
 
 // TransactionManager 트랜잭션 관리자
 type TransactionManager struct {
-	db *sql.DB
+	db        *sql.DB
+	replicaDB *sql.DB // 조회 전용 읽기 복제본 (없으면 db 사용)
+	txOptions *sql.TxOptions
+	retry     RetryPolicy
+	logger    *zap.Logger
+	stats     txStats
+}
+
+// RetryPolicy 데드락/락 대기 시간 초과 시 트랜잭션 재시도 정책
+type RetryPolicy struct {
+	MaxRetries  int           // 최대 재시도 횟수 (0 이면 재시도하지 않음)
+	BaseBackoff time.Duration // 첫 재시도 대기 시간 (재시도마다 2배)
+	MaxBackoff  time.Duration // 최대 대기 시간
+}
+
+// DefaultRetryPolicy 기본 재시도 정책
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:  3,
+		BaseBackoff: 20 * time.Millisecond,
+		MaxBackoff:  500 * time.Millisecond,
+	}
+}
+
+// backoff attempt 번째 재시도 전 대기 시간 (지수 증가 + jitter)
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.BaseBackoff << uint(attempt-1)
+	if d <= 0 || d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	// 동시에 충돌한 트랜잭션이 같은 시점에 재시도하지 않도록 절반 범위에서 jitter 적용
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// ParseIsolationLevel 격리 수준 이름을 sql.IsolationLevel 로 변환
+// READ-UNCOMMITTED, READ-COMMITTED, REPEATABLE-READ, SERIALIZABLE (공백/밑줄 구분도 허용)
+func ParseIsolationLevel(name string) (sql.IsolationLevel, error) {
+	normalized := strings.ToUpper(strings.NewReplacer(" ", "-", "_", "-").Replace(strings.TrimSpace(name)))
+	switch normalized {
+	case "":
+		return sql.LevelDefault, nil
+	case "READ-UNCOMMITTED":
+		return sql.LevelReadUncommitted, nil
+	case "READ-COMMITTED":
+		return sql.LevelReadCommitted, nil
+	case "REPEATABLE-READ":
+		return sql.LevelRepeatableRead, nil
+	case "SERIALIZABLE":
+		return sql.LevelSerializable, nil
+	default:
+		return sql.LevelDefault, fmt.Errorf("unknown transaction isolation level: %s", name)
+	}
+}
+
+// TxStats 트랜잭션 재시도 통계
+type TxStats struct {
+	Retries   int64 // 재시도 횟수
+	Exhausted int64 // 재시도 횟수를 모두 소진하고 실패한 트랜잭션 수
+}
+
+// Since prev 이후 증가한 재시도 통계 (주기적 리포트용)
+func (s TxStats) Since(prev TxStats) TxStats {
+	return TxStats{
+		Retries:   s.Retries - prev.Retries,
+		Exhausted: s.Exhausted - prev.Exhausted,
+	}
+}
+
+// LogTxStatsDelta prev 이후 증가한 재시도 통계 기록 (재시도 소진은 Warn, 재시도만 있으면 Info, 없으면 Debug)
+func LogTxStatsDelta(logger *zap.Logger, stats, prev TxStats) {
+	delta := stats.Since(prev)
+	fields := []zap.Field{
+		zap.Int64("retries", delta.Retries),
+		zap.Int64("exhausted", delta.Exhausted),
+		zap.Int64("total_retries", stats.Retries),
+		zap.Int64("total_exhausted", stats.Exhausted),
+	}
+
+	switch {
+	case delta.Exhausted > 0:
+		logger.Warn("Transaction retries exhausted since last report", fields...)
+	case delta.Retries > 0:
+		logger.Info("Transaction retry stats", fields...)
+	default:
+		logger.Debug("Transaction retry stats", fields...)
+	}
+}
+
+// txStats 동시 접근 가능한 재시도 카운터
+type txStats struct {
+	retries   atomic.Int64
+	exhausted atomic.Int64
 }
 
 // NewTransactionManager 트랜잭션 관리자 생성
 func NewTransactionManager(db *sql.DB) *TransactionManager {
-	return &TransactionManager{db: db}
+	return &TransactionManager{
+		db:     db,
+		retry:  DefaultRetryPolicy(),
+		logger: zap.NewNop(),
+	}
+}
+
+// WithReadReplica 조회 전용 쿼리를 보낼 읽기 복제본 설정
+func (tm *TransactionManager) WithReadReplica(replicaDB *sql.DB) *TransactionManager {
+	tm.replicaDB = replicaDB
+	return tm
+}
+
+// WithRetryPolicy 재시도 정책 설정
+func (tm *TransactionManager) WithRetryPolicy(policy RetryPolicy) *TransactionManager {
+	tm.retry = policy
+	return tm
+}
+
+// WithTxOptions 트랜잭션 기본 옵션(격리 수준 등) 설정
+func (tm *TransactionManager) WithTxOptions(opts *sql.TxOptions) *TransactionManager {
+	tm.txOptions = opts
+	return tm
+}
+
+// WithLogger 재시도 로그를 남길 로거 설정
+func (tm *TransactionManager) WithLogger(logger *zap.Logger) *TransactionManager {
+	tm.logger = logger
+	return tm
 }
 
-// WithTransaction 트랜잭션 내에서 함수 실행
+// Stats 트랜잭션 재시도 통계 조회
+func (tm *TransactionManager) Stats() TxStats {
+	return TxStats{
+		Retries:   tm.stats.retries.Load(),
+		Exhausted: tm.stats.exhausted.Load(),
+	}
+}
+
+// WithTransaction 트랜잭션 내에서 함수 실행 (기본 옵션 사용)
+// 컨텍스트에 이미 트랜잭션이 있으면 새로 시작하지 않고 해당 트랜잭션에 참여
 func (tm *TransactionManager) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
-	tx, err := tm.db.BeginTx(ctx, nil)
+	return tm.WithTransactionOptions(ctx, tm.txOptions, fn)
+}
+
+// WithTransactionOptions 지정한 옵션(격리 수준 등)으로 트랜잭션 내에서 함수 실행
+// 데드락(1213)이나 락 대기 시간 초과(1205)로 실패하면 재시도 정책에 따라 fn 을 처음부터 다시 실행
+// 컨텍스트에 이미 트랜잭션이 있으면 참여하며, 재시도는 최상위 트랜잭션에서만 수행
+func (tm *TransactionManager) WithTransactionOptions(ctx context.Context, opts *sql.TxOptions, fn func(context.Context) error) error {
+	if GetTx(ctx) != nil {
+		return fn(ctx)
+	}
+
+	for attempt := 0; ; attempt++ {
+		err := tm.runTransaction(ctx, opts, fn)
+		if err == nil || !isRetryableError(err) {
+			return err
+		}
+
+		if attempt >= tm.retry.MaxRetries {
+			tm.stats.exhausted.Add(1)
+			tm.logger.Error("Transaction retries exhausted",
+				zap.Int("attempts", attempt+1),
+				zap.Error(err),
+			)
+			return err
+		}
+
+		backoff := tm.retry.backoff(attempt + 1)
+		tm.stats.retries.Add(1)
+		tm.logger.Warn("Retrying transaction after lock conflict",
+			zap.Int("attempt", attempt+1),
+			zap.Duration("backoff", backoff),
+			zap.Error(err),
+		)
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// runTransaction 트랜잭션 1회 실행 (실패 시 롤백, 성공 시 커밋 후 커밋 후 함수 실행)
+func (tm *TransactionManager) runTransaction(ctx context.Context, opts *sql.TxOptions, fn func(context.Context) error) error {
+	tx, err := tm.db.BeginTx(ctx, opts)
 	if err != nil {
 		return err
 	}
 
-	// 트랜잭션 컨텍스트 생성
+	// 트랜잭션 컨텍스트 생성 (커밋 후 실행할 함수 목록 포함)
+	hooks := &afterCommitHooks{}
 	txCtx := context.WithValue(ctx, "tx", tx)
+	txCtx = context.WithValue(txCtx, "afterCommit", hooks)
 
 	// 함수 실행
 	if err := fn(txCtx); err != nil {
@@ -38,9 +223,30 @@ func (tm *TransactionManager) WithTransaction(ctx context.Context, fn func(conte
 		return err
 	}
 
+	// 커밋 후 함수 실행 (트랜잭션 밖에서 실행)
+	for _, hook := range hooks.fns {
+		hook(ctx)
+	}
+
 	return nil
 }
 
+// AfterCommit 최상위 트랜잭션이 커밋된 후 실행할 함수 등록
+// 롤백되면 실행하지 않으며, 트랜잭션 밖에서 호출하면 즉시 실행
+func (tm *TransactionManager) AfterCommit(ctx context.Context, fn func(context.Context)) {
+	hooks, ok := ctx.Value("afterCommit").(*afterCommitHooks)
+	if !ok || GetTx(ctx) == nil {
+		fn(ctx)
+		return
+	}
+	hooks.fns = append(hooks.fns, fn)
+}
+
+// afterCommitHooks 커밋 후 실행할 함수 목록
+type afterCommitHooks struct {
+	fns []func(context.Context)
+}
+
 // GetTx 컨텍스트에서 트랜잭션 추출
 func GetTx(ctx context.Context) *sql.Tx {
 	if tx, ok := ctx.Value("tx").(*sql.Tx); ok {
@@ -60,3 +266,20 @@ func (tm *TransactionManager) GetDBOrTx(ctx context.Context) interface {
 	}
 	return tm.db
 }
+
+// GetReadDBOrTx 조회 전용 쿼리용 DB 반환
+// 트랜잭션 내에서는 트랜잭션을, 밖에서는 읽기 복제본(설정된 경우) 또는 DB 반환
+// 복제 지연이 있을 수 있으므로 잔액 변경 판단에는 사용하지 않음
+func (tm *TransactionManager) GetReadDBOrTx(ctx context.Context) interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+} {
+	if tx := GetTx(ctx); tx != nil {
+		return tx
+	}
+	if tm.replicaDB != nil {
+		return tm.replicaDB
+	}
+	return tm.db
+}
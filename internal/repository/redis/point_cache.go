@@ -66,8 +66,13 @@ func (c *PointCache) DeleteBalance(ctx context.Context, userID int64) error {
 
 // BalanceCache 잔액 캐시 구조체
 type BalanceCache struct {
-	AvailableBalance int64 `json:"available_balance"`
-	PendingBalance   int64 `json:"pending_balance"`
-	TotalEarned      int64 `json:"total_earned"`
-	TotalUsed        int64 `json:"total_used"`
+	AvailableBalance int64     `json:"available_balance"`
+	PendingBalance   int64     `json:"pending_balance"`
+	HeldBalance      int64     `json:"held_balance"`
+	DebtBalance      int64     `json:"debt_balance"`
+	TotalEarned      int64     `json:"total_earned"`
+	TotalUsed        int64     `json:"total_used"`
+	UpdatedAt        time.Time `json:"updated_at"`
+
+	WalletBalances map[string]int64 `json:"wallet_balances,omitempty"` // 지갑별 사용 가능 포인트
 }
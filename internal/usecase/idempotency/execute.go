@@ -0,0 +1,96 @@
+package idempotency
+
+import (
+	"context"
+	"shopping-mall/internal/domain/idempotency"
+	"time"
+)
+
+// ExecuteUseCase 멱등성 키로 보호된 요청 실행 유스케이스
+type ExecuteUseCase struct {
+	repo idempotency.Repository
+	tm   idempotency.TransactionManager
+	ttl  time.Duration // 키 유효기간
+}
+
+// NewExecuteUseCase 멱등성 실행 유스케이스 생성
+func NewExecuteUseCase(repo idempotency.Repository, tm idempotency.TransactionManager, ttl time.Duration) *ExecuteUseCase {
+	return &ExecuteUseCase{
+		repo: repo,
+		tm:   tm,
+		ttl:  ttl,
+	}
+}
+
+// Execute 키 선점과 fn 실행, 응답 기록을 하나의 트랜잭션으로 처리
+// 같은 키로 이미 처리된 요청이면 fn 을 실행하지 않고 기록된 응답을 반환 (replayed = true)
+// fn 이 실패하면 키 선점도 롤백되어 같은 키로 재시도 가능
+// 유효기간이 지난 키는 아직 삭제되지 않았어도 새 요청으로 처리
+func (uc *ExecuteUseCase) Execute(
+	ctx context.Context,
+	scope, key, requestHash string,
+	fn func(context.Context) (*idempotency.Response, error),
+) (resp *idempotency.Response, replayed bool, err error) {
+	err = uc.tm.WithTransaction(ctx, func(txCtx context.Context) error {
+		// 1. 키 선점 (동시 요청은 유니크 키 락에서 대기)
+		now := time.Now()
+		record := &idempotency.Record{
+			Scope:       scope,
+			Key:         key,
+			RequestHash: requestHash,
+			ExpiresAt:   now.Add(uc.ttl),
+		}
+		if err := uc.repo.Create(txCtx, record); err != nil {
+			if err != idempotency.ErrDuplicateKey {
+				return err
+			}
+
+			// 2. 이미 처리된 요청이면 기록된 응답 반환 (만료된 키는 삭제 후 다시 선점)
+			existing, err := uc.repo.Get(txCtx, scope, key)
+			if err != nil {
+				return err
+			}
+			if !existing.IsExpired(now) {
+				if !existing.Matches(requestHash) {
+					return idempotency.ErrKeyReused
+				}
+
+				resp = existing.Response()
+				replayed = true
+				return nil
+			}
+
+			if err := uc.repo.Delete(txCtx, existing.ID); err != nil {
+				return err
+			}
+			if err := uc.repo.Create(txCtx, record); err != nil {
+				return err
+			}
+		}
+
+		// 3. 요청 처리 (같은 트랜잭션 내에서 실행)
+		result, err := fn(txCtx)
+		if err != nil {
+			return err
+		}
+
+		// 4. 응답 기록
+		record.ResponseStatus = result.Status
+		record.ResponseBody = result.Body
+		if err := uc.repo.UpdateResponse(txCtx, record); err != nil {
+			return err
+		}
+
+		resp = result
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return resp, replayed, nil
+}
+
+// PurgeExpired before 이전에 만료된 키를 최대 limit 개 삭제 (삭제한 개수 반환)
+func (uc *ExecuteUseCase) PurgeExpired(ctx context.Context, before time.Time, limit int) (int64, error) {
+	return uc.repo.DeleteExpired(ctx, before, limit)
+}
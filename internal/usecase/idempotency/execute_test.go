@@ -0,0 +1,128 @@
+package idempotency
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"shopping-mall/internal/domain/idempotency"
+)
+
+// fakeRepository 테스트용 인메모리 멱등성 키 저장소
+type fakeRepository struct {
+	records map[string]*idempotency.Record
+	nextID  int64
+}
+
+func newFakeRepository() *fakeRepository {
+	return &fakeRepository{records: make(map[string]*idempotency.Record)}
+}
+
+func (r *fakeRepository) Create(ctx context.Context, record *idempotency.Record) error {
+	if _, ok := r.records[record.Scope+"|"+record.Key]; ok {
+		return idempotency.ErrDuplicateKey
+	}
+	r.nextID++
+	record.ID = r.nextID
+	copied := *record
+	r.records[record.Scope+"|"+record.Key] = &copied
+	return nil
+}
+
+func (r *fakeRepository) Get(ctx context.Context, scope, key string) (*idempotency.Record, error) {
+	record, ok := r.records[scope+"|"+key]
+	if !ok {
+		return nil, idempotency.ErrRecordNotFound
+	}
+	copied := *record
+	return &copied, nil
+}
+
+func (r *fakeRepository) UpdateResponse(ctx context.Context, record *idempotency.Record) error {
+	copied := *record
+	r.records[record.Scope+"|"+record.Key] = &copied
+	return nil
+}
+
+func (r *fakeRepository) Delete(ctx context.Context, id int64) error {
+	for k, record := range r.records {
+		if record.ID == id {
+			delete(r.records, k)
+		}
+	}
+	return nil
+}
+
+func (r *fakeRepository) DeleteExpired(ctx context.Context, before time.Time, limit int) (int64, error) {
+	var deleted int64
+	for k, record := range r.records {
+		if deleted < int64(limit) && record.ExpiresAt.Before(before) {
+			delete(r.records, k)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// fakeTransactionManager 트랜잭션 없이 fn 실행
+type fakeTransactionManager struct{}
+
+func (fakeTransactionManager) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestExecuteReplaysUntilExpired(t *testing.T) {
+	repo := newFakeRepository()
+	uc := NewExecuteUseCase(repo, fakeTransactionManager{}, time.Hour)
+	ctx := context.Background()
+
+	calls := 0
+	fn := func(context.Context) (*idempotency.Response, error) {
+		calls++
+		return &idempotency.Response{Status: http.StatusOK, Body: []byte(`{}`)}, nil
+	}
+
+	if _, replayed, err := uc.Execute(ctx, "scope", "key", "hash", fn); err != nil || replayed {
+		t.Fatalf("first Execute() replayed %v error %v", replayed, err)
+	}
+	if _, replayed, err := uc.Execute(ctx, "scope", "key", "hash", fn); err != nil || !replayed {
+		t.Fatalf("retry Execute() replayed %v error %v", replayed, err)
+	}
+	if _, _, err := uc.Execute(ctx, "scope", "key", "other", fn); err != idempotency.ErrKeyReused {
+		t.Fatalf("reused key error = %v, want %v", err, idempotency.ErrKeyReused)
+	}
+	if calls != 1 {
+		t.Fatalf("fn called %d times before expiry, want 1", calls)
+	}
+
+	// 유효기간이 지난 키는 다른 페이로드여도 새 요청으로 처리
+	repo.records["scope|key"].ExpiresAt = time.Now().Add(-time.Minute)
+	if _, replayed, err := uc.Execute(ctx, "scope", "key", "other", fn); err != nil || replayed {
+		t.Fatalf("Execute() after expiry replayed %v error %v", replayed, err)
+	}
+	if calls != 2 {
+		t.Errorf("fn called %d times after expiry, want 2", calls)
+	}
+	if record := repo.records["scope|key"]; record.RequestHash != "other" || !record.ExpiresAt.After(time.Now()) {
+		t.Errorf("expired key not re-claimed: %+v", record)
+	}
+}
+
+func TestPurgeExpired(t *testing.T) {
+	repo := newFakeRepository()
+	uc := NewExecuteUseCase(repo, fakeTransactionManager{}, time.Hour)
+	now := time.Now()
+
+	for i, expiresAt := range []time.Time{now.Add(-2 * time.Hour), now.Add(-time.Hour), now.Add(time.Hour)} {
+		_ = repo.Create(context.Background(), &idempotency.Record{Scope: "scope", Key: string(rune('a' + i)), ExpiresAt: expiresAt})
+	}
+
+	purged, err := uc.PurgeExpired(context.Background(), now, 10)
+	if err != nil || purged != 2 {
+		t.Fatalf("PurgeExpired() = %d, %v, want 2", purged, err)
+	}
+	if len(repo.records) != 1 {
+		t.Errorf("%d keys left, want 1", len(repo.records))
+	}
+}
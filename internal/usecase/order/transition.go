@@ -0,0 +1,317 @@
+package order
+
+import (
+	"context"
+	"shopping-mall/internal/domain/order"
+	"shopping-mall/internal/domain/point"
+	pointUseCase "shopping-mall/internal/usecase/point"
+	"time"
+)
+
+// TransitionUseCase 주문 상태 전이 유스케이스
+// 상태 전이와 포인트 사용/적립/환불을 하나의 트랜잭션으로 처리하고, 주문에 기록된 금액과 포인트 거래 내역을 대조
+type TransitionUseCase struct {
+	repo          order.Repository
+	pointRepo     point.Repository
+	tm            order.TransactionManager
+	useUseCase    *pointUseCase.UsePointsUseCase
+	earnUseCase   *pointUseCase.EarnPointsUseCase
+	refundUseCase *pointUseCase.RefundPointsUseCase
+}
+
+// NewTransitionUseCase 주문 상태 전이 유스케이스 생성
+func NewTransitionUseCase(
+	repo order.Repository,
+	pointRepo point.Repository,
+	tm order.TransactionManager,
+	useUseCase *pointUseCase.UsePointsUseCase,
+	earnUseCase *pointUseCase.EarnPointsUseCase,
+	refundUseCase *pointUseCase.RefundPointsUseCase,
+) *TransitionUseCase {
+	return &TransitionUseCase{
+		repo:          repo,
+		pointRepo:     pointRepo,
+		tm:            tm,
+		useUseCase:    useUseCase,
+		earnUseCase:   earnUseCase,
+		refundUseCase: refundUseCase,
+	}
+}
+
+// GetOrder 사용자 주문 조회 (다른 사용자의 주문이면 ErrOrderNotFound)
+func (uc *TransitionUseCase) GetOrder(ctx context.Context, userID, orderID int64) (*order.Order, error) {
+	o, err := uc.repo.Get(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if o.UserID != userID {
+		return nil, order.ErrOrderNotFound
+	}
+	return o, nil
+}
+
+// Pay 결제 완료 (PENDING → PAID, 사용 포인트 차감)
+// 예약 확정이나 포인트 사용 API 로 이미 차감한 주문은 차감 금액이 사용 포인트와 같은지만 검증
+func (uc *TransitionUseCase) Pay(ctx context.Context, userID, orderID, orderAmount, pointUsed int64) (*order.Order, error) {
+	var o *order.Order
+	err := uc.tm.WithTransaction(ctx, func(txCtx context.Context) error {
+		// 1. 주문 조회 (없으면 결제 전 주문 생성)
+		var err error
+		o, err = uc.repo.GetForUpdate(txCtx, orderID)
+		if err == order.ErrOrderNotFound {
+			o = order.New(orderID, userID, time.Now())
+			err = uc.repo.Create(txCtx, o)
+		}
+		if err != nil {
+			return err
+		}
+		if o.UserID != userID {
+			return order.ErrOrderNotFound
+		}
+		if o.Status == order.StatusPaid {
+			return point.ErrOrderAlreadyUsed
+		}
+
+		// 2. 상태 전이 및 주문 금액 기록
+		if err := o.Pay(orderAmount, pointUsed, time.Now()); err != nil {
+			return err
+		}
+
+		// 3. 포인트 차감 (이미 차감된 포인트가 있으면 사용 포인트와 대조)
+		used, _, err := uc.pointTotals(txCtx, orderID)
+		if err != nil {
+			return err
+		}
+		switch {
+		case used == pointUsed:
+		case used == 0:
+			if err := uc.useUseCase.UsePoints(txCtx, userID, pointUsed, orderAmount, orderID); err != nil {
+				return err
+			}
+		default:
+			return order.ErrPointTotalsMismatch
+		}
+
+		// 4. 주문 저장
+		return uc.repo.Update(txCtx, o)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// Confirm 구매 확정 (PAID → CONFIRMED, 적립 예정 포인트 등록)
+// 결제 금액이 기록된 주문 금액과 같고, 사용 포인트 거래 합계가 기록된 사용 포인트와 같을 때만 적립
+func (uc *TransitionUseCase) Confirm(ctx context.Context, userID int64, purchase *point.PurchaseOrder) (*order.Order, error) {
+	var o *order.Order
+	err := uc.tm.WithTransaction(ctx, func(txCtx context.Context) error {
+		// 1. 주문 조회 (결제 단계가 기록되지 않은 주문은 포인트 거래 내역으로 복원)
+		var err error
+		o, err = uc.getOrAdopt(txCtx, userID, purchase.OrderID, purchase.PaymentAmount)
+		if err != nil {
+			return err
+		}
+		if o.Status == order.StatusConfirmed {
+			return point.ErrOrderAlreadyEarned
+		}
+		if !o.CanTransitionTo(order.StatusConfirmed) {
+			return order.ErrInvalidTransition
+		}
+
+		// 2. 기록된 주문 금액/사용 포인트 대조
+		if err := o.MatchesTotal(purchase.PaymentAmount); err != nil {
+			return err
+		}
+		used, _, err := uc.pointTotals(txCtx, purchase.OrderID)
+		if err != nil {
+			return err
+		}
+		if err := o.ValidatePointUsed(used); err != nil {
+			return err
+		}
+
+		// 3. 구매 적립
+		if err := uc.earnUseCase.EarnPointsFromPurchase(txCtx, userID, purchase); err != nil {
+			return err
+		}
+
+		// 4. 적립 예정 포인트 기록 및 상태 전이
+		_, earned, err := uc.pointTotals(txCtx, purchase.OrderID)
+		if err != nil {
+			return err
+		}
+		if err := o.Confirm(earned, time.Now()); err != nil {
+			return err
+		}
+		return uc.repo.Update(txCtx, o)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// Cancel 구매 확정 전 취소 (PENDING/PAID → CANCELLED, 사용 포인트 복구)
+func (uc *TransitionUseCase) Cancel(ctx context.Context, userID, orderID int64) (*order.Order, error) {
+	var o *order.Order
+	err := uc.tm.WithTransaction(ctx, func(txCtx context.Context) error {
+		// 1. 주문 조회
+		var err error
+		o, err = uc.getOrAdopt(txCtx, userID, orderID, 0)
+		if err != nil {
+			return err
+		}
+		if o.Status == order.StatusCancelled {
+			return order.ErrOrderCancelled
+		}
+		paid := o.Status == order.StatusPaid
+
+		// 2. 상태 전이
+		if err := o.Cancel(time.Now()); err != nil {
+			return err
+		}
+
+		// 3. 결제된 주문이면 사용 포인트 복구
+		if paid {
+			if err := uc.refundPoints(txCtx, userID, orderID); err != nil {
+				return err
+			}
+		}
+		return uc.repo.Update(txCtx, o)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// Refund 전체 환불 (→ REFUNDED, 사용 포인트 복구/적립 포인트 회수)
+func (uc *TransitionUseCase) Refund(ctx context.Context, userID, orderID int64) (*order.Order, error) {
+	var o *order.Order
+	err := uc.tm.WithTransaction(ctx, func(txCtx context.Context) error {
+		// 1. 주문 조회
+		var err error
+		o, err = uc.getOrAdopt(txCtx, userID, orderID, 0)
+		if err != nil {
+			return err
+		}
+		if o.Status == order.StatusRefunded {
+			return point.ErrOrderAlreadyRefunded
+		}
+
+		// 2. 상태 전이
+		if err := o.Refund(true, time.Now()); err != nil {
+			return err
+		}
+
+		// 3. 포인트 환불
+		if err := uc.refundPoints(txCtx, userID, orderID); err != nil {
+			return err
+		}
+		return uc.repo.Update(txCtx, o)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// RefundPartial 부분 환불 (→ PARTIALLY_REFUNDED, 남은 결제 금액이 없으면 REFUNDED)
+// 원 결제 금액이 기록된 주문 금액과 같을 때만 환불
+func (uc *TransitionUseCase) RefundPartial(
+	ctx context.Context,
+	userID, orderID int64,
+	paymentAmount, refundPaymentAmount int64,
+	refundPointAmount *int64,
+	lines []point.RefundLine,
+) (*point.OrderRefund, error) {
+	var refund *point.OrderRefund
+	err := uc.tm.WithTransaction(ctx, func(txCtx context.Context) error {
+		// 1. 주문 조회 및 주문 금액 대조
+		o, err := uc.getOrAdopt(txCtx, userID, orderID, paymentAmount)
+		if err != nil {
+			return err
+		}
+		if o.Status == order.StatusRefunded {
+			return point.ErrOrderAlreadyRefunded
+		}
+		if !o.CanTransitionTo(order.StatusPartiallyRefunded) {
+			return order.ErrInvalidTransition
+		}
+		if err := o.MatchesTotal(paymentAmount); err != nil {
+			return err
+		}
+
+		// 2. 포인트 부분 환불
+		refund, err = uc.refundUseCase.RefundPartial(txCtx, userID, orderID, paymentAmount, refundPaymentAmount, refundPointAmount, lines)
+		if err != nil {
+			return err
+		}
+
+		// 3. 상태 전이 (누적 환불 기준)
+		if err := o.Refund(refund.IsFullyRefunded(), time.Now()); err != nil {
+			return err
+		}
+		return uc.repo.Update(txCtx, o)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return refund, nil
+}
+
+// getOrAdopt 주문 조회 (락 포함)
+// 주문이 없으면 포인트 거래 내역으로 복원하며, totalAmount 를 모르고(0) 거래 내역도 없으면 ErrOrderNotFound
+func (uc *TransitionUseCase) getOrAdopt(ctx context.Context, userID, orderID, totalAmount int64) (*order.Order, error) {
+	o, err := uc.repo.GetForUpdate(ctx, orderID)
+	if err == nil {
+		if o.UserID != userID {
+			return nil, order.ErrOrderNotFound
+		}
+		return o, nil
+	}
+	if err != order.ErrOrderNotFound {
+		return nil, err
+	}
+
+	used, earned, err := uc.pointTotals(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if totalAmount <= 0 && used == 0 && earned == 0 {
+		return nil, order.ErrOrderNotFound
+	}
+
+	o = order.Adopt(orderID, userID, totalAmount, used, earned, time.Now())
+	if err := uc.repo.Create(ctx, o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// pointTotals 주문의 구매 포인트 사용/적립 거래 합계 (취소된 거래 제외)
+func (uc *TransitionUseCase) pointTotals(ctx context.Context, orderID int64) (used, earned int64, err error) {
+	transactions, err := uc.pointRepo.GetTransactionsByOrderID(ctx, orderID)
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, tx := range transactions {
+		switch {
+		case tx.IsPurchaseUse():
+			used += tx.Amount
+		case tx.IsPurchaseEarn():
+			earned += tx.Amount
+		}
+	}
+	return used, earned, nil
+}
+
+// refundPoints 주문 포인트 환불 (포인트 정보가 없는 사용자는 환불할 포인트가 없으므로 성공)
+func (uc *TransitionUseCase) refundPoints(ctx context.Context, userID, orderID int64) error {
+	err := uc.refundUseCase.RefundPoints(ctx, userID, orderID)
+	if err == point.ErrPointNotFound {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,333 @@
+package orderevent
+
+import (
+	"context"
+	"shopping-mall/internal/domain/order"
+	"shopping-mall/internal/domain/orderevent"
+	"shopping-mall/internal/domain/point"
+	orderUseCase "shopping-mall/internal/usecase/order"
+	"sync/atomic"
+	"time"
+)
+
+// ConsumeUseCase 주문 수명주기 이벤트 소비 유스케이스
+// 주문 서비스의 동기 HTTP 호출(확정/환불) 대신 이벤트를 받아 주문 상태 전이와 포인트 사용/적립/환불을 처리
+type ConsumeUseCase struct {
+	repo              orderevent.Repository
+	tm                orderevent.TransactionManager
+	source            orderevent.Source
+	transitionUseCase *orderUseCase.TransitionUseCase
+	policy            point.DispatchPolicy
+	stats             consumerStats
+}
+
+// NewConsumeUseCase 주문 이벤트 소비 유스케이스 생성
+// policy 일시적 오류로 처리에 실패한 메시지의 재시도 백오프와 보관 전 최대 시도 횟수 (0 이면 무제한)
+func NewConsumeUseCase(
+	repo orderevent.Repository,
+	tm orderevent.TransactionManager,
+	source orderevent.Source,
+	transitionUseCase *orderUseCase.TransitionUseCase,
+	policy point.DispatchPolicy,
+) *ConsumeUseCase {
+	return &ConsumeUseCase{
+		repo:              repo,
+		tm:                tm,
+		source:            source,
+		transitionUseCase: transitionUseCase,
+		policy:            policy,
+	}
+}
+
+// ConsumeResult 이벤트 소비 결과
+type ConsumeResult struct {
+	Fetched    int           // 조회한 메시지 수
+	Processed  int           // 포인트 처리 완료
+	Duplicates int           // 이미 처리한 이벤트
+	Skipped    int           // 처리하지 않는 유형의 이벤트
+	Retrying   int           // 일시적 오류로 백오프 후 재시도
+	Parked     int           // 처리할 수 없어 보관 (poison message)
+	Deferred   int           // 재시도 대기 중이거나 같은 주문의 앞선 이벤트가 대기 중이라 다음 실행으로 미룬 메시지
+	Lag        time.Duration // 가장 오래된 메시지가 소스에 들어온 뒤 지난 시간 (소비 지연)
+}
+
+// ConsumerStats 프로세스 시작 이후 누적 소비 지표
+type ConsumerStats struct {
+	Processed  int64
+	Duplicates int64
+	Skipped    int64
+	Retried    int64
+	Parked     int64
+	LastLag    time.Duration // 마지막 처리 메시지가 소스에 들어온 뒤 처리되기까지 걸린 시간
+	EventLag   time.Duration // 마지막 처리 이벤트가 주문 서비스에서 발생한 뒤 처리되기까지 걸린 시간
+}
+
+// consumerStats 동시 접근 가능한 소비 지표
+type consumerStats struct {
+	processed  atomic.Int64
+	duplicates atomic.Int64
+	skipped    atomic.Int64
+	retried    atomic.Int64
+	parked     atomic.Int64
+	lastLag    atomic.Int64
+	eventLag   atomic.Int64
+}
+
+// Stats 누적 소비 지표 조회
+func (uc *ConsumeUseCase) Stats() ConsumerStats {
+	return ConsumerStats{
+		Processed:  uc.stats.processed.Load(),
+		Duplicates: uc.stats.duplicates.Load(),
+		Skipped:    uc.stats.skipped.Load(),
+		Retried:    uc.stats.retried.Load(),
+		Parked:     uc.stats.parked.Load(),
+		LastLag:    time.Duration(uc.stats.lastLag.Load()),
+		EventLag:   time.Duration(uc.stats.eventLag.Load()),
+	}
+}
+
+// Consume 소스에서 메시지를 최대 limit 개 받아 순서대로 처리
+// 이벤트 ID 로 중복을 제거하므로 같은 이벤트가 여러 번 전달되어도 포인트는 한 번만 처리
+// 해석할 수 없거나 포인트 정책상 처리할 수 없는 메시지, 최대 시도 횟수를 넘긴 메시지는 보관하고 확인(Ack)
+// 일시적 오류로 실패한 메시지는 확인하지 않고 시도 횟수와 다음 시도 시각(지수 백오프)을 저장해 그때까지 다시 처리하지 않으며,
+// 같은 주문의 뒤 메시지는 순서를 지키기 위해 다음 실행으로 미룸
+func (uc *ConsumeUseCase) Consume(ctx context.Context, now time.Time, limit int) (*ConsumeResult, error) {
+	// 1. 처리할 메시지 조회
+	messages, err := uc.source.Fetch(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ConsumeResult{Fetched: len(messages)}
+	for _, msg := range messages {
+		if lag := now.Sub(msg.ReceivedAt); lag > result.Lag {
+			result.Lag = lag
+		}
+	}
+
+	blocked := make(map[int64]bool)
+	for _, msg := range messages {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		// 2. 메시지 해석 (다른 유형 이벤트는 건너뛰고, 해석할 수 없는 메시지는 재시도해도 같으므로 바로 보관)
+		event, err := decodeEvent(msg.Body)
+		if err == orderevent.ErrUnknownEventType {
+			uc.stats.skipped.Add(1)
+			result.Skipped++
+			if err := uc.source.Ack(ctx, msg); err != nil {
+				return result, err
+			}
+			continue
+		}
+		if err != nil {
+			if err := uc.park(ctx, msg, nil, 1, err); err != nil {
+				return result, err
+			}
+			result.Parked++
+			continue
+		}
+
+		// 3. 앞 메시지가 재시도 대기 중인 주문과 백오프가 끝나지 않은 메시지는 건너뜀
+		if blocked[event.OrderID] {
+			result.Deferred++
+			continue
+		}
+		attempt, err := uc.getAttempt(ctx, msg)
+		if err != nil {
+			return result, err
+		}
+		if !attempt.IsDue(now) {
+			blocked[event.OrderID] = true
+			result.Deferred++
+			continue
+		}
+
+		// 4. 포인트 처리 및 결과 기록
+		err = uc.process(ctx, event)
+		switch {
+		case err == nil:
+			uc.recordLag(msg, event)
+			uc.stats.processed.Add(1)
+			result.Processed++
+		case err == orderevent.ErrDuplicateEvent:
+			uc.stats.duplicates.Add(1)
+			result.Duplicates++
+		case isPermanentError(err):
+			if err := uc.park(ctx, msg, event, attempt.Attempts+1, err); err != nil {
+				return result, err
+			}
+			result.Parked++
+			continue
+		case attempt.Fail(err, now, uc.policy):
+			if err := uc.park(ctx, msg, event, attempt.Attempts, err); err != nil {
+				return result, err
+			}
+			result.Parked++
+			continue
+		default:
+			if err := uc.repo.SaveMessageAttempt(ctx, attempt); err != nil {
+				return result, err
+			}
+			uc.stats.retried.Add(1)
+			blocked[event.OrderID] = true
+			result.Retrying++
+			continue
+		}
+
+		if attempt.Attempts > 0 {
+			if err := uc.repo.DeleteMessageAttempt(ctx, msg.ID); err != nil {
+				return result, err
+			}
+		}
+		if err := uc.source.Ack(ctx, msg); err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// process 이벤트 처리 기록과 포인트 처리를 하나의 트랜잭션으로 실행
+func (uc *ConsumeUseCase) process(ctx context.Context, event *orderevent.Event) error {
+	return uc.tm.WithTransaction(ctx, func(txCtx context.Context) error {
+		// 1. 이벤트 ID 선점 (이미 처리한 이벤트면 ErrDuplicateEvent)
+		if err := uc.repo.CreateProcessedEvent(txCtx, orderevent.NewProcessedEvent(event, time.Now())); err != nil {
+			return err
+		}
+
+		// 2. 포인트 처리 (같은 트랜잭션에 참여해 처리 기록과 함께 커밋)
+		err := uc.apply(txCtx, event)
+		if isAlreadyApplied(event, err) {
+			return nil
+		}
+		return err
+	})
+}
+
+// apply 이벤트 유형별 주문 상태 전이 및 포인트 처리
+func (uc *ConsumeUseCase) apply(ctx context.Context, event *orderevent.Event) error {
+	var err error
+	switch event.EventType {
+	case orderevent.EventTypeOrderPaid:
+		_, err = uc.transitionUseCase.Pay(ctx, event.UserID, event.OrderID, event.OrderAmount, event.PointUsed)
+
+	case orderevent.EventTypeOrderConfirmed:
+		_, err = uc.transitionUseCase.Confirm(ctx, event.UserID, &point.PurchaseOrder{
+			OrderID:       event.OrderID,
+			PaymentAmount: event.PaymentAmount,
+			Categories:    event.Categories,
+			Items:         event.Items,
+		})
+
+	case orderevent.EventTypeOrderCancelled:
+		_, err = uc.transitionUseCase.Cancel(ctx, event.UserID, event.OrderID)
+
+	case orderevent.EventTypeOrderRefunded:
+		if event.IsPartialRefund() {
+			_, err = uc.transitionUseCase.RefundPartial(ctx, event.UserID, event.OrderID,
+				event.PaymentAmount, event.RefundPaymentAmount, event.RefundPointAmount, event.RefundLines)
+		} else {
+			_, err = uc.transitionUseCase.Refund(ctx, event.UserID, event.OrderID)
+		}
+
+	default:
+		err = orderevent.ErrUnknownEventType
+	}
+	return err
+}
+
+// getAttempt 메시지 처리 실패 기록 조회 (실패한 적이 없으면 바로 처리할 수 있는 빈 기록)
+func (uc *ConsumeUseCase) getAttempt(ctx context.Context, msg *orderevent.Message) (*orderevent.MessageAttempt, error) {
+	attempt, err := uc.repo.GetMessageAttempt(ctx, msg.ID)
+	if err == orderevent.ErrAttemptNotFound {
+		return &orderevent.MessageAttempt{MessageID: msg.ID}, nil
+	}
+	return attempt, err
+}
+
+// park 메시지를 보관하고 확인 (다시 전달하지 않음)
+func (uc *ConsumeUseCase) park(ctx context.Context, msg *orderevent.Message, event *orderevent.Event, attempts int, cause error) error {
+	parked := &orderevent.ParkedMessage{
+		MessageID: msg.ID,
+		Body:      msg.Body,
+		Reason:    cause.Error(),
+		Attempts:  attempts,
+		ParkedAt:  time.Now(),
+	}
+	if event != nil {
+		parked.EventID = event.EventID
+		parked.EventType = event.EventType
+	}
+
+	if err := uc.repo.CreateParkedMessage(ctx, parked); err != nil {
+		return err
+	}
+	// 앞선 실패로 저장된 시도 기록 삭제
+	if attempts > 1 {
+		if err := uc.repo.DeleteMessageAttempt(ctx, msg.ID); err != nil {
+			return err
+		}
+	}
+	uc.stats.parked.Add(1)
+	return uc.source.Ack(ctx, msg)
+}
+
+// recordLag 처리한 메시지의 소비 지연 기록
+func (uc *ConsumeUseCase) recordLag(msg *orderevent.Message, event *orderevent.Event) {
+	now := time.Now()
+	if !msg.ReceivedAt.IsZero() {
+		uc.stats.lastLag.Store(int64(now.Sub(msg.ReceivedAt)))
+	}
+	if !event.OccurredAt.IsZero() {
+		uc.stats.eventLag.Store(int64(now.Sub(event.OccurredAt)))
+	}
+}
+
+// isAlreadyApplied 이미 반영된 주문인 경우
+// 이벤트 소비와 동기 HTTP 호출을 함께 운영하는 동안 같은 주문이 양쪽으로 들어와도 성공으로 처리
+// (유스케이스는 검증 단계에서 반환하므로 트랜잭션에 남는 변경은 없음)
+// 주문 조회 실패(없는 주문, 다른 사용자의 주문 포함)는 반영 여부를 알 수 없으므로 그대로 오류로 반환
+func isAlreadyApplied(event *orderevent.Event, err error) bool {
+	switch event.EventType {
+	case orderevent.EventTypeOrderPaid:
+		return err == point.ErrOrderAlreadyUsed
+	case orderevent.EventTypeOrderConfirmed:
+		return err == point.ErrOrderAlreadyEarned
+	case orderevent.EventTypeOrderCancelled:
+		return err == order.ErrOrderCancelled
+	case orderevent.EventTypeOrderRefunded:
+		return err == point.ErrOrderAlreadyRefunded
+	}
+	return false
+}
+
+// isPermanentError 재시도해도 같은 결과가 나오는 오류 (주문 상태/포인트 정책/요청 값 오류)
+func isPermanentError(err error) bool {
+	switch err {
+	case orderevent.ErrInvalidEvent,
+		orderevent.ErrUnknownEventType,
+		order.ErrOrderNotFound,
+		order.ErrInvalidTransition,
+		order.ErrInvalidOrderAmount,
+		order.ErrTotalMismatch,
+		order.ErrPointTotalsMismatch,
+		point.ErrInsufficientPoints,
+		point.ErrBelowMinUseAmount,
+		point.ErrInvalidUseUnit,
+		point.ErrExceedMaxUseRate,
+		point.ErrBelowMinPayment,
+		point.ErrPointNotFound,
+		point.ErrInvalidUserID,
+		point.ErrInvalidLineItem,
+		point.ErrOrderLineNotFound,
+		point.ErrInvalidRefundAmount,
+		point.ErrRefundExceedsPayment,
+		point.ErrRefundExceedsUsedPoints,
+		point.ErrRefundPaymentMismatch,
+		point.ErrRefundExceedsQuantity:
+		return true
+	}
+	return false
+}
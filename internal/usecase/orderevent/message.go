@@ -0,0 +1,85 @@
+package orderevent
+
+import (
+	"encoding/json"
+	"shopping-mall/internal/domain/orderevent"
+	"shopping-mall/internal/domain/point"
+	"strings"
+	"time"
+)
+
+// eventMessage 주문 이벤트 메시지 본문 (JSON)
+type eventMessage struct {
+	EventID    string    `json:"event_id"`
+	EventType  string    `json:"event_type"`
+	OrderID    int64     `json:"order_id"`
+	UserID     int64     `json:"user_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+
+	OrderAmount         int64               `json:"order_amount,omitempty"`
+	PointUsed           int64               `json:"point_used,omitempty"`
+	PaymentAmount       int64               `json:"payment_amount,omitempty"`
+	Categories          []string            `json:"categories,omitempty"`
+	Items               []lineItemMessage   `json:"items,omitempty"`
+	RefundPaymentAmount int64               `json:"refund_payment_amount,omitempty"`
+	RefundPointAmount   *int64              `json:"refund_point_amount,omitempty"`
+	RefundLines         []refundLineMessage `json:"refund_lines,omitempty"`
+}
+
+// lineItemMessage 주문 라인 (point_eligible 이 없으면 적립 대상)
+type lineItemMessage struct {
+	LineNo        int    `json:"line_no"`
+	SKU           string `json:"sku"`
+	Category      string `json:"category"`
+	Quantity      int64  `json:"quantity"`
+	UnitPrice     int64  `json:"unit_price"`
+	Discount      int64  `json:"discount,omitempty"`
+	PointEligible *bool  `json:"point_eligible,omitempty"`
+}
+
+// refundLineMessage 환불 라인
+type refundLineMessage struct {
+	LineNo   int   `json:"line_no"`
+	Quantity int64 `json:"quantity"`
+}
+
+// decodeEvent 메시지 본문을 주문 이벤트로 변환하고 검증
+func decodeEvent(body []byte) (*orderevent.Event, error) {
+	var msg eventMessage
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return nil, err
+	}
+
+	event := &orderevent.Event{
+		EventID:             msg.EventID,
+		EventType:           orderevent.EventType(strings.ToUpper(msg.EventType)),
+		OrderID:             msg.OrderID,
+		UserID:              msg.UserID,
+		OccurredAt:          msg.OccurredAt,
+		OrderAmount:         msg.OrderAmount,
+		PointUsed:           msg.PointUsed,
+		PaymentAmount:       msg.PaymentAmount,
+		Categories:          msg.Categories,
+		RefundPaymentAmount: msg.RefundPaymentAmount,
+		RefundPointAmount:   msg.RefundPointAmount,
+	}
+	for _, item := range msg.Items {
+		event.Items = append(event.Items, point.LineItem{
+			LineNo:        item.LineNo,
+			SKU:           item.SKU,
+			Category:      item.Category,
+			Quantity:      item.Quantity,
+			UnitPrice:     item.UnitPrice,
+			Discount:      item.Discount,
+			PointEligible: item.PointEligible == nil || *item.PointEligible,
+		})
+	}
+	for _, line := range msg.RefundLines {
+		event.RefundLines = append(event.RefundLines, point.RefundLine{LineNo: line.LineNo, Quantity: line.Quantity})
+	}
+
+	if err := event.Validate(); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
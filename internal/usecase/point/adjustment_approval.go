@@ -0,0 +1,187 @@
+package point
+
+import (
+	"context"
+	"shopping-mall/internal/domain/point"
+	"time"
+)
+
+// AdjustmentApprovalUseCase 관리자 포인트 조정 승인(maker-checker) 유스케이스
+// 기준 금액 이하 조정은 바로 실행하고, 초과하는 조정은 승인 요청으로 저장한 뒤 다른 운영자가 승인하면 실행
+type AdjustmentApprovalUseCase struct {
+	repo          point.Repository
+	tm            point.TransactionManager
+	policy        *point.Policy
+	adjustUseCase *AdminAdjustPointsUseCase
+}
+
+// NewAdjustmentApprovalUseCase 관리자 포인트 조정 승인 유스케이스 생성
+func NewAdjustmentApprovalUseCase(repo point.Repository, tm point.TransactionManager, policy *point.Policy, adjustUseCase *AdminAdjustPointsUseCase) *AdjustmentApprovalUseCase {
+	return &AdjustmentApprovalUseCase{
+		repo:          repo,
+		tm:            tm,
+		policy:        policy,
+		adjustUseCase: adjustUseCase,
+	}
+}
+
+// AdjustmentResult 관리자 포인트 조정 요청 결과 (실행된 거래 또는 승인 대기 요청 중 하나)
+type AdjustmentResult struct {
+	Transaction *point.Transaction
+	Approval    *point.AdjustmentApproval
+}
+
+// Submit 관리자 포인트 조정 요청 (기준 금액 초과 시 승인 요청 생성)
+func (uc *AdjustmentApprovalUseCase) Submit(ctx context.Context, direction point.AdjustmentDirection, adjustment *point.AdminAdjustment) (*AdjustmentResult, error) {
+	if err := adjustment.Validate(); err != nil {
+		return nil, err
+	}
+
+	// 1. 기준 금액 이하는 바로 실행
+	if !uc.policy.RequiresAdminApproval(adjustment.Amount) {
+		transaction, err := uc.execute(ctx, direction, adjustment)
+		if err != nil {
+			return nil, err
+		}
+		return &AdjustmentResult{Transaction: transaction}, nil
+	}
+
+	// 2. 승인 시점이 아닌 요청 시점에 유효기간 검증
+	now := time.Now()
+	if direction == point.AdjustmentDirectionCredit {
+		if _, err := uc.policy.CalculateAdminGrantExpiry(adjustment.Wallet, now, adjustment.ExpiresInDays); err != nil {
+			return nil, err
+		}
+	}
+
+	// 3. 승인 요청 생성
+	approval := point.NewAdjustmentApproval(direction, adjustment, uc.policy.CalculateApprovalExpiry(now))
+	if err := uc.repo.CreateAdjustmentApproval(ctx, approval); err != nil {
+		return nil, err
+	}
+	return &AdjustmentResult{Approval: approval}, nil
+}
+
+// Approve 승인 요청 승인 후 조정 실행 (승인과 원장 기록은 같은 트랜잭션)
+// 승인 대기 시간이 지났으면 만료 처리 후 ErrApprovalExpired 반환
+func (uc *AdjustmentApprovalUseCase) Approve(ctx context.Context, approvalID int64, operatorID, note string) (*AdjustmentResult, error) {
+	var result *AdjustmentResult
+	var expired bool
+	err := uc.tm.WithTransaction(ctx, func(txCtx context.Context) error {
+		// 1. 승인 요청 조회 (FOR UPDATE 락)
+		approval, err := uc.repo.GetAdjustmentApprovalForUpdate(txCtx, approvalID)
+		if err != nil {
+			return err
+		}
+
+		// 2. 승인 대기 시간이 지났으면 만료 처리
+		now := time.Now()
+		if approval.IsPending() && approval.IsExpired(now) {
+			approval.Expire(now)
+			expired = true
+			return uc.repo.UpdateAdjustmentApproval(txCtx, approval)
+		}
+
+		// 3. 승인 (다른 운영자인지 검증)
+		if err := approval.Approve(operatorID, note, now); err != nil {
+			return err
+		}
+
+		// 4. 조정 실행 (같은 트랜잭션에 참여)
+		transaction, err := uc.execute(txCtx, approval.Direction, approval.Adjustment())
+		if err != nil {
+			return err
+		}
+
+		// 5. 승인 결과 기록
+		approval.TransactionID = &transaction.ID
+		if err := uc.repo.UpdateAdjustmentApproval(txCtx, approval); err != nil {
+			return err
+		}
+
+		result = &AdjustmentResult{Transaction: transaction, Approval: approval}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if expired {
+		return nil, point.ErrApprovalExpired
+	}
+	return result, nil
+}
+
+// Reject 승인 요청 반려
+func (uc *AdjustmentApprovalUseCase) Reject(ctx context.Context, approvalID int64, operatorID, note string) (*point.AdjustmentApproval, error) {
+	var approval *point.AdjustmentApproval
+	err := uc.tm.WithTransaction(ctx, func(txCtx context.Context) error {
+		// 1. 승인 요청 조회 (FOR UPDATE 락)
+		var err error
+		approval, err = uc.repo.GetAdjustmentApprovalForUpdate(txCtx, approvalID)
+		if err != nil {
+			return err
+		}
+
+		// 2. 반려 (다른 운영자인지 검증)
+		if err := approval.Reject(operatorID, note, time.Now()); err != nil {
+			return err
+		}
+
+		return uc.repo.UpdateAdjustmentApproval(txCtx, approval)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return approval, nil
+}
+
+// ListApprovals 승인 요청 목록 조회 (status 가 비어 있으면 전체)
+func (uc *AdjustmentApprovalUseCase) ListApprovals(ctx context.Context, status point.ApprovalStatus, limit, offset int) ([]*point.AdjustmentApproval, error) {
+	return uc.repo.GetAdjustmentApprovals(ctx, status, limit, offset)
+}
+
+// ExpireStale 승인 대기 시간이 지난 승인 요청 만료 처리 (만료 처리한 건수 반환)
+func (uc *AdjustmentApprovalUseCase) ExpireStale(ctx context.Context, before time.Time, limit int) (int, error) {
+	var expired int
+	err := uc.tm.WithTransaction(ctx, func(txCtx context.Context) error {
+		// 재시도 시 처음부터 다시 집계
+		expired = 0
+
+		// 1. 만료 대상 조회
+		approvals, err := uc.repo.GetStaleAdjustmentApprovals(txCtx, before, limit)
+		if err != nil {
+			return err
+		}
+
+		// 2. 요청별 락 획득 후 아직 대기 중인지 다시 확인하고 만료 처리
+		for _, stale := range approvals {
+			approval, err := uc.repo.GetAdjustmentApprovalForUpdate(txCtx, stale.ID)
+			if err != nil {
+				return err
+			}
+			if !approval.IsPending() {
+				continue
+			}
+
+			approval.Expire(time.Now())
+			if err := uc.repo.UpdateAdjustmentApproval(txCtx, approval); err != nil {
+				return err
+			}
+			expired++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return expired, nil
+}
+
+// execute 구분에 따라 관리자 포인트 지급/차감 실행
+func (uc *AdjustmentApprovalUseCase) execute(ctx context.Context, direction point.AdjustmentDirection, adjustment *point.AdminAdjustment) (*point.Transaction, error) {
+	if direction == point.AdjustmentDirectionDebit {
+		return uc.adjustUseCase.Debit(ctx, adjustment)
+	}
+	return uc.adjustUseCase.Credit(ctx, adjustment)
+}
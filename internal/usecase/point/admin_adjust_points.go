@@ -0,0 +1,154 @@
+package point
+
+import (
+	"context"
+	"shopping-mall/internal/domain/point"
+	"shopping-mall/internal/repository/redis"
+	"time"
+)
+
+// AdminAdjustPointsUseCase 관리자 수동 포인트 지급/차감 유스케이스
+type AdminAdjustPointsUseCase struct {
+	repo   point.Repository
+	tm     point.TransactionManager
+	policy *point.Policy
+	cache  *redis.PointCache
+}
+
+// NewAdminAdjustPointsUseCase 관리자 포인트 조정 유스케이스 생성
+func NewAdminAdjustPointsUseCase(repo point.Repository, tm point.TransactionManager, policy *point.Policy, cache *redis.PointCache) *AdminAdjustPointsUseCase {
+	return &AdminAdjustPointsUseCase{
+		repo:   repo,
+		tm:     tm,
+		policy: policy,
+		cache:  cache,
+	}
+}
+
+// Credit 관리자 포인트 지급 (부채가 있으면 먼저 상계)
+func (uc *AdminAdjustPointsUseCase) Credit(ctx context.Context, adjustment *point.AdminAdjustment) (*point.Transaction, error) {
+	if err := adjustment.Validate(); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	expiresAt, err := uc.policy.CalculateAdminGrantExpiry(adjustment.Wallet, now, adjustment.ExpiresInDays)
+	if err != nil {
+		return nil, err
+	}
+
+	var transaction *point.Transaction
+	err = uc.tm.WithTransaction(ctx, func(txCtx context.Context) error {
+		// 1. 포인트 잔액 조회 (FOR UPDATE 락, 없으면 생성)
+		userPoint, err := uc.repo.GetUserPointForUpdate(txCtx, adjustment.UserID)
+		if err == point.ErrPointNotFound {
+			userPoint = &point.UserPoint{
+				UserID:    adjustment.UserID,
+				UpdatedAt: now,
+			}
+			if err := uc.repo.CreateUserPoint(txCtx, userPoint); err != nil {
+				return err
+			}
+		} else if err != nil {
+			return err
+		}
+
+		// 2. 포인트 지급 (부채가 있으면 먼저 상계)
+		offset := userPoint.Earn(adjustment.Amount)
+
+		// 3. 지급 거래 내역 생성 (운영자/티켓 기록)
+		transaction = &point.Transaction{
+			UserID:          adjustment.UserID,
+			Type:            point.TransactionTypeEarn,
+			Amount:          adjustment.Amount,
+			RemainingAmount: adjustment.Amount - offset,
+			BalanceAfter:    userPoint.AvailableBalance,
+			ReasonType:      point.ReasonTypeAdmin,
+			ReasonDetail:    adjustment.Reason,
+			OperatorID:      adjustment.OperatorID,
+			TicketRef:       adjustment.TicketRef,
+			Wallet:          adjustment.Wallet.OrDefault(),
+			EarnedAt:        &now,
+			ExpiresAt:       expiresAt,
+			Status:          point.TransactionStatusConfirmed,
+			CreatedAt:       now,
+		}
+		if err := uc.repo.CreateTransaction(txCtx, transaction); err != nil {
+			return err
+		}
+		if err := recordEvent(txCtx, uc.repo, point.EventTypeAdjusted, transaction); err != nil {
+			return err
+		}
+
+		// 4. 잔액 업데이트
+		invalidateBalance(txCtx, uc.tm, uc.cache, userPoint.UserID)
+		return uc.repo.UpdateUserPoint(txCtx, userPoint)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return transaction, nil
+}
+
+// Debit 관리자 포인트 차감 (사용 가능 포인트를 초과하는 차감은 거부)
+func (uc *AdminAdjustPointsUseCase) Debit(ctx context.Context, adjustment *point.AdminAdjustment) (*point.Transaction, error) {
+	if err := adjustment.Validate(); err != nil {
+		return nil, err
+	}
+
+	var transaction *point.Transaction
+	err := uc.tm.WithTransaction(ctx, func(txCtx context.Context) error {
+		// 1. 포인트 잔액 조회 (FOR UPDATE 락)
+		userPoint, err := uc.repo.GetUserPointForUpdate(txCtx, adjustment.UserID)
+		if err != nil {
+			return err
+		}
+
+		// 2. 지갑 사용 순서대로 적립 lot 에서 차감할 금액 계산 (같은 지갑은 FIFO)
+		if err := userPoint.CanUse(adjustment.Amount); err != nil {
+			return err
+		}
+		plan, err := planLotConsumption(txCtx, uc.repo, adjustment.UserID, adjustment.Amount, uc.policy.WalletUseOrder)
+		if err != nil {
+			return err
+		}
+
+		// 3. 포인트 차감 (도메인 로직)
+		if err := userPoint.Deduct(adjustment.Amount); err != nil {
+			return err
+		}
+
+		// 4. 차감 거래 내역 생성 (운영자/티켓 기록)
+		transaction = &point.Transaction{
+			UserID:       adjustment.UserID,
+			Type:         point.TransactionTypeCancel,
+			Amount:       adjustment.Amount,
+			BalanceAfter: userPoint.AvailableBalance,
+			ReasonType:   point.ReasonTypeAdmin,
+			ReasonDetail: adjustment.Reason,
+			OperatorID:   adjustment.OperatorID,
+			TicketRef:    adjustment.TicketRef,
+			Status:       point.TransactionStatusConfirmed,
+			CreatedAt:    time.Now(),
+		}
+		if err := uc.repo.CreateTransaction(txCtx, transaction); err != nil {
+			return err
+		}
+		if err := recordEvent(txCtx, uc.repo, point.EventTypeAdjusted, transaction); err != nil {
+			return err
+		}
+
+		// 5. 적립 lot 잔여 포인트 차감 및 차감 내역 기록
+		if err := plan.save(txCtx, uc.repo, transaction.ID); err != nil {
+			return err
+		}
+
+		// 6. 잔액 업데이트
+		invalidateBalance(txCtx, uc.tm, uc.cache, userPoint.UserID)
+		return uc.repo.UpdateUserPoint(txCtx, userPoint)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return transaction, nil
+}
@@ -0,0 +1,37 @@
+package point
+
+import (
+	"context"
+	"shopping-mall/internal/domain/point"
+	"shopping-mall/internal/repository/redis"
+)
+
+// invalidateBalance 트랜잭션 커밋 후 사용자 잔액 캐시 삭제
+// 롤백된 변경이 캐시에 남거나 커밋 전 잔액이 다시 캐싱되지 않도록 커밋 이후에만 삭제
+func invalidateBalance(ctx context.Context, tm point.TransactionManager, cache *redis.PointCache, userID int64) {
+	if cache == nil {
+		return
+	}
+	tm.AfterCommit(ctx, func(ctx context.Context) {
+		_ = cache.DeleteBalance(ctx, userID)
+	})
+}
+
+// toBalanceCache 사용자 포인트를 잔액 캐시로 변환
+func toBalanceCache(userPoint *point.UserPoint) *redis.BalanceCache {
+	walletBalances := make(map[string]int64, len(userPoint.WalletBalances))
+	for wallet, amount := range userPoint.WalletBalances {
+		walletBalances[string(wallet)] = amount
+	}
+
+	return &redis.BalanceCache{
+		AvailableBalance: userPoint.AvailableBalance,
+		PendingBalance:   userPoint.PendingBalance,
+		HeldBalance:      userPoint.HeldBalance,
+		DebtBalance:      userPoint.DebtBalance,
+		TotalEarned:      userPoint.TotalEarned,
+		TotalUsed:        userPoint.TotalUsed,
+		UpdatedAt:        userPoint.UpdatedAt,
+		WalletBalances:   walletBalances,
+	}
+}
@@ -0,0 +1,179 @@
+package point
+
+import (
+	"context"
+	"fmt"
+	"shopping-mall/internal/domain/point"
+	"time"
+)
+
+// CampaignUseCase 프로모션 적립 캠페인 관리 유스케이스
+type CampaignUseCase struct {
+	repo point.Repository
+}
+
+// NewCampaignUseCase 캠페인 관리 유스케이스 생성
+func NewCampaignUseCase(repo point.Repository) *CampaignUseCase {
+	return &CampaignUseCase{
+		repo: repo,
+	}
+}
+
+// CreateCampaign 캠페인 생성
+func (uc *CampaignUseCase) CreateCampaign(ctx context.Context, campaign *point.Campaign) error {
+	if err := campaign.Validate(); err != nil {
+		return err
+	}
+	campaign.Wallet = campaign.Wallet.OrDefault()
+	campaign.SpentBudget = 0
+	return uc.repo.CreateCampaign(ctx, campaign)
+}
+
+// ListCampaigns 캠페인 목록 조회
+func (uc *CampaignUseCase) ListCampaigns(ctx context.Context, limit, offset int) ([]*point.Campaign, error) {
+	return uc.repo.GetCampaigns(ctx, limit, offset)
+}
+
+// GetCampaignCost 캠페인과 추가 적립 비용 집계 조회
+func (uc *CampaignUseCase) GetCampaignCost(ctx context.Context, campaignID int64) (*point.Campaign, *point.CampaignCost, error) {
+	campaign, err := uc.repo.GetCampaign(ctx, campaignID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cost, err := uc.repo.GetCampaignCost(ctx, campaignID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return campaign, cost, nil
+}
+
+// applyCampaigns 진행 중인 캠페인 중 대상인 캠페인마다 추가 적립 거래 생성
+// 추가 적립은 기본 적립 거래와 같은 사유/주문/상태/적립일로 기록하여 환불/적립 확정을 함께 따르고,
+// 캠페인 지갑에 적립되어 만료일은 지갑별 유효기간을 따름
+// 예산은 캠페인 행을 잠근 뒤 남은 금액만큼만 지급
+func applyCampaigns(
+	ctx context.Context,
+	repo point.Repository,
+	policy *point.Policy,
+	userPoint *point.UserPoint,
+	base *point.Transaction,
+	ec *point.EarnContext,
+) error {
+	campaigns, err := repo.GetActiveCampaigns(ctx, ec.At)
+	if err != nil {
+		return err
+	}
+
+	for _, active := range campaigns {
+		if !active.IsEligible(ec) {
+			continue
+		}
+
+		// 예산 확인 (캠페인 행 락)
+		campaign, err := repo.GetCampaignForUpdate(ctx, active.ID)
+		if err != nil {
+			return err
+		}
+		userBonus, err := repo.GetCampaignUserBonus(ctx, campaign.ID, ec.UserID)
+		if err != nil {
+			return err
+		}
+		bonus := campaign.CapBonus(campaign.CalculateBonus(ec), userBonus)
+		if bonus <= 0 {
+			continue
+		}
+
+		// 추가 적립 (적립 예정이면 적립 예정 포인트로, 확정이면 부채 상계 후 적립)
+		transaction := &point.Transaction{
+			UserID:       ec.UserID,
+			Type:         point.TransactionTypeEarn,
+			Amount:       bonus,
+			ReasonType:   base.ReasonType,
+			ReasonDetail: fmt.Sprintf("%s 캠페인 추가 적립", campaign.Name),
+			CampaignID:   &campaign.ID,
+			Wallet:       campaign.Wallet.OrDefault(),
+			OrderID:      base.OrderID,
+			EarnedAt:     base.EarnedAt,
+			ScheduledAt:  base.ScheduledAt,
+			Status:       base.Status,
+			CreatedAt:    time.Now(),
+		}
+		if base.EarnedAt != nil {
+			transaction.ExpiresAt = policy.CalculateWalletExpiry(transaction.Wallet, *base.EarnedAt)
+		}
+		if base.Status == point.TransactionStatusPending {
+			userPoint.AddPending(bonus)
+		} else {
+			offset := userPoint.Earn(bonus)
+			transaction.RemainingAmount = bonus - offset
+		}
+		transaction.BalanceAfter = userPoint.AvailableBalance
+
+		if err := repo.CreateTransaction(ctx, transaction); err != nil {
+			return err
+		}
+		if err := recordEvent(ctx, repo, point.EventTypeEarned, transaction); err != nil {
+			return err
+		}
+
+		campaign.Spend(bonus)
+		if err := repo.UpdateCampaignSpent(ctx, campaign); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CampaignBonus 견적에 포함한 캠페인 추가 적립 예정 포인트
+type CampaignBonus struct {
+	CampaignID int64
+	Name       string
+	Points     int64
+}
+
+// quoteCampaigns 진행 중인 캠페인 중 대상인 캠페인의 추가 적립 예정 포인트 계산
+// 조회 전용으로 캠페인 행을 잠그거나 예산을 차감하지 않으며, 조회 시점의 남은 예산으로 제한
+func quoteCampaigns(ctx context.Context, repo point.Repository, ec *point.EarnContext) ([]CampaignBonus, int64, error) {
+	campaigns, err := repo.GetActiveCampaigns(ctx, ec.At)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var bonuses []CampaignBonus
+	var total int64
+	for _, campaign := range campaigns {
+		if !campaign.IsEligible(ec) {
+			continue
+		}
+
+		userBonus, err := repo.GetCampaignUserBonus(ctx, campaign.ID, ec.UserID)
+		if err != nil {
+			return nil, 0, err
+		}
+		bonus := campaign.CapBonus(campaign.CalculateBonus(ec), userBonus)
+		if bonus <= 0 {
+			continue
+		}
+
+		bonuses = append(bonuses, CampaignBonus{CampaignID: campaign.ID, Name: campaign.Name, Points: bonus})
+		total += bonus
+	}
+	return bonuses, total, nil
+}
+
+// releaseCampaignBudget 취소/회수된 캠페인 추가 적립 거래의 amount 만큼 캠페인 예산 반환
+// 캠페인 추가 적립이 아니면 무시
+func releaseCampaignBudget(ctx context.Context, repo point.Repository, tx *point.Transaction, amount int64) error {
+	if tx.CampaignID == nil || amount <= 0 {
+		return nil
+	}
+
+	campaign, err := repo.GetCampaignForUpdate(ctx, *tx.CampaignID)
+	if err != nil {
+		return err
+	}
+	campaign.Release(amount)
+	return repo.UpdateCampaignSpent(ctx, campaign)
+}
@@ -0,0 +1,53 @@
+package point
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"shopping-mall/internal/domain/point"
+)
+
+func TestQuoteCampaigns(t *testing.T) {
+	now := time.Now()
+	repo := newFakeRepository()
+	repo.campaigns = []*point.Campaign{
+		{ID: 1, Name: "double", BonusType: point.CampaignBonusMultiplier, BonusValue: 2, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour)},
+		{ID: 2, Name: "gold only", BonusType: point.CampaignBonusFlat, BonusValue: 1000, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour), Tiers: []point.Tier{point.TierGold}},
+		{ID: 3, Name: "almost spent", BonusType: point.CampaignBonusRate, BonusValue: 0.03, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour), TotalBudget: 1000, SpentBudget: 800},
+		{ID: 4, Name: "user cap reached", BonusType: point.CampaignBonusFlat, BonusValue: 500, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour), UserBudget: 500},
+		{ID: 5, Name: "ended", BonusType: point.CampaignBonusFlat, BonusValue: 500, StartsAt: now.Add(-2 * time.Hour), EndsAt: now.Add(-time.Hour)},
+	}
+	repo.userBonuses[4] = 500
+
+	bonuses, total, err := quoteCampaigns(context.Background(), repo, &point.EarnContext{
+		UserID:        1,
+		Tier:          point.TierBronze,
+		ReasonType:    point.ReasonTypePurchase,
+		PaymentAmount: 10000,
+		BaseAmount:    100,
+		At:            now,
+	})
+	if err != nil {
+		t.Fatalf("quoteCampaigns() error = %v", err)
+	}
+
+	want := []CampaignBonus{
+		{CampaignID: 1, Name: "double", Points: 100},
+		{CampaignID: 3, Name: "almost spent", Points: 200},
+	}
+	if len(bonuses) != len(want) {
+		t.Fatalf("bonuses = %+v, want %+v", bonuses, want)
+	}
+	for i := range want {
+		if bonuses[i] != want[i] {
+			t.Errorf("bonuses[%d] = %+v, want %+v", i, bonuses[i], want[i])
+		}
+	}
+	if total != 300 {
+		t.Errorf("total = %d, want 300", total)
+	}
+	if repo.campaigns[2].SpentBudget != 800 {
+		t.Errorf("quote changed spent budget to %d", repo.campaigns[2].SpentBudget)
+	}
+}
@@ -0,0 +1,131 @@
+package point
+
+import (
+	"context"
+	"fmt"
+	"shopping-mall/internal/domain/point"
+	"shopping-mall/internal/repository/redis"
+	"time"
+)
+
+// ConfirmPendingPointsUseCase 적립 예정 포인트 확정 유스케이스
+type ConfirmPendingPointsUseCase struct {
+	repo   point.Repository
+	tm     point.TransactionManager
+	policy *point.Policy
+	cache  *redis.PointCache
+}
+
+// NewConfirmPendingPointsUseCase 적립 예정 포인트 확정 유스케이스 생성
+func NewConfirmPendingPointsUseCase(repo point.Repository, tm point.TransactionManager, policy *point.Policy, cache *redis.PointCache) *ConfirmPendingPointsUseCase {
+	return &ConfirmPendingPointsUseCase{
+		repo:   repo,
+		tm:     tm,
+		policy: policy,
+		cache:  cache,
+	}
+}
+
+// ConfirmResult 적립 확정 배치 결과
+type ConfirmResult struct {
+	Checked   int   // 확정 대상 사용자 수
+	Confirmed int   // 적립 예정 포인트를 확정한 사용자 수
+	Amount    int64 // 확정한 포인트 합계
+	Failed    int   // 확정 처리에 실패한 사용자 수
+}
+
+// ConfirmPendingPoints 적립 확정 예정일이 지난 적립 예정 포인트를 사용 가능 포인트로 전환
+// 사용자마다 별도 트랜잭션으로 처리하여 여러 사용자의 락을 한 번에 잡지 않고, 실패한 사용자는 건너뛴 뒤 첫 오류를 반환
+func (uc *ConfirmPendingPointsUseCase) ConfirmPendingPoints(ctx context.Context, before time.Time, limit int) (*ConfirmResult, error) {
+	result := &ConfirmResult{}
+
+	// 1. 확정 대상 사용자 조회 (락 없음)
+	userIDs, err := uc.repo.GetDuePendingUserIDs(ctx, before, limit)
+	if err != nil {
+		return result, err
+	}
+
+	// 2. 사용자별 확정 처리
+	var firstErr error
+	for _, userID := range userIDs {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		result.Checked++
+		amount, err := uc.ConfirmUser(ctx, userID, before)
+		if err != nil {
+			result.Failed++
+			if firstErr == nil {
+				firstErr = fmt.Errorf("failed to confirm pending points of user %d: %w", userID, err)
+			}
+			continue
+		}
+		if amount > 0 {
+			result.Confirmed++
+			result.Amount += amount
+		}
+	}
+
+	return result, firstErr
+}
+
+// ConfirmUser 사용자의 확정 예정일이 지난 적립 예정 포인트 확정 (확정한 포인트 합계 반환)
+// 사용자 포인트를 먼저 잠근 뒤 적립 예정 거래를 FOR UPDATE 로 다시 읽어, 동시에 취소/감액된 적립을 확정하지 않음
+func (uc *ConfirmPendingPointsUseCase) ConfirmUser(ctx context.Context, userID int64, before time.Time) (int64, error) {
+	var total int64
+	err := uc.tm.WithTransaction(ctx, func(txCtx context.Context) error {
+		// 재시도 시 처음부터 다시 집계
+		total = 0
+
+		// 1. 포인트 잔액 조회 (FOR UPDATE 락)
+		userPoint, err := uc.repo.GetUserPointForUpdate(txCtx, userID)
+		if err == point.ErrPointNotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// 2. 확정 대상 적립 예정 거래 재조회 (FOR UPDATE 락)
+		transactions, err := uc.repo.GetDuePendingTransactionsForUpdate(txCtx, userID, before)
+		if err != nil {
+			return err
+		}
+		if len(transactions) == 0 {
+			return nil
+		}
+
+		_, policy, err := resolvePolicy(txCtx, uc.repo, uc.policy, userID)
+		if err != nil {
+			return err
+		}
+
+		// 3. 적립 예정 → 사용 가능 포인트 전환 (부채가 있으면 먼저 상계)
+		for _, tx := range transactions {
+			offset := userPoint.ConfirmPending(tx.Amount)
+			total += tx.Amount
+
+			// 확정 시점 기준으로 적립일/지갑별 만료일 설정 (상계된 포인트는 lot 에서 제외)
+			now := time.Now()
+			tx.ConfirmEarn(now, policy.CalculateWalletExpiry(tx.Wallet, now))
+			tx.Consume(offset)
+			tx.BalanceAfter = userPoint.AvailableBalance
+
+			if err := uc.repo.UpdateTransaction(txCtx, tx); err != nil {
+				return err
+			}
+			if err := recordEvent(txCtx, uc.repo, point.EventTypeEarnConfirmed, tx); err != nil {
+				return err
+			}
+		}
+
+		// 4. 잔액 업데이트
+		invalidateBalance(txCtx, uc.tm, uc.cache, userPoint.UserID)
+		return uc.repo.UpdateUserPoint(txCtx, userPoint)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
@@ -0,0 +1,77 @@
+package point
+
+import (
+	"context"
+	"shopping-mall/internal/domain/point"
+	"time"
+)
+
+// DispatchEventsUseCase outbox 이벤트 발행 유스케이스
+type DispatchEventsUseCase struct {
+	repo      point.Repository
+	publisher point.EventPublisher
+	policy    point.DispatchPolicy
+}
+
+// NewDispatchEventsUseCase outbox 이벤트 발행 유스케이스 생성
+func NewDispatchEventsUseCase(repo point.Repository, publisher point.EventPublisher, policy point.DispatchPolicy) *DispatchEventsUseCase {
+	return &DispatchEventsUseCase{
+		repo:      repo,
+		publisher: publisher,
+		policy:    policy,
+	}
+}
+
+// DispatchResult 이벤트 발행 결과
+type DispatchResult struct {
+	Fetched   int // 조회한 이벤트 수
+	Published int // 발행 완료
+	Retrying  int // 발행 실패 후 재시도 대기
+	Parked    int // 최대 시도 횟수 초과로 보류 (FAILED)
+	Deferred  int // 같은 사용자의 앞선 이벤트가 실패해 다음 실행으로 미룬 이벤트
+}
+
+// Dispatch now 시점에 발행할 이벤트를 ID 순으로 최대 limit 개 발행 (at-least-once)
+// 발행 후 상태 저장에 실패하면 다음 실행에서 다시 발행하므로 구독자는 EventID 로 중복 제거
+// 사용자별 순서를 지키기 위해 발행에 실패한 사용자의 뒤 이벤트는 앞 이벤트가 발행될 때까지 미룸
+func (uc *DispatchEventsUseCase) Dispatch(ctx context.Context, now time.Time, limit int) (*DispatchResult, error) {
+	// 1. 발행할 이벤트 조회 (재시도 대기 중인 이벤트가 있는 사용자의 뒤 이벤트 제외)
+	events, err := uc.repo.GetDispatchableOutboxEvents(ctx, now, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DispatchResult{Fetched: len(events)}
+	blocked := make(map[int64]bool)
+	for _, event := range events {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		// 2. 이번 실행에서 앞 이벤트 발행에 실패한 사용자는 건너뜀
+		if blocked[event.UserID] {
+			result.Deferred++
+			continue
+		}
+
+		// 3. 발행 및 결과 기록
+		if err := uc.publisher.Publish(ctx, event); err != nil {
+			event.MarkFailed(err, time.Now(), uc.policy)
+			if event.Status == point.OutboxStatusFailed {
+				result.Parked++
+			} else {
+				blocked[event.UserID] = true
+				result.Retrying++
+			}
+		} else {
+			event.MarkPublished(time.Now())
+			result.Published++
+		}
+
+		if err := uc.repo.UpdateOutboxEvent(ctx, event); err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
@@ -3,6 +3,7 @@ package point
 import (
 	"context"
 	"shopping-mall/internal/domain/point"
+	"shopping-mall/internal/repository/redis"
 	"time"
 )
 
@@ -11,22 +12,30 @@ type EarnPointsUseCase struct {
 	repo   point.Repository
 	tm     point.TransactionManager
 	policy *point.Policy
+	cache  *redis.PointCache
 }
 
 // NewEarnPointsUseCase 포인트 적립 유스케이스 생성
-func NewEarnPointsUseCase(repo point.Repository, tm point.TransactionManager, policy *point.Policy) *EarnPointsUseCase {
+func NewEarnPointsUseCase(repo point.Repository, tm point.TransactionManager, policy *point.Policy, cache *redis.PointCache) *EarnPointsUseCase {
 	return &EarnPointsUseCase{
 		repo:   repo,
 		tm:     tm,
 		policy: policy,
+		cache:  cache,
 	}
 }
 
-// EarnPointsFromPurchase 구매 적립
-func (uc *EarnPointsUseCase) EarnPointsFromPurchase(ctx context.Context, userID int64, paymentAmount int64, orderID int64) error {
+// EarnPointsFromPurchase 구매 적립 (적립 지연 일수 후 확정되는 적립 예정 포인트로 적립)
+// 주문 라인이 있으면 카테고리/상품 규칙으로 라인별 적립을 계산해 라인 내역을 저장 (부분 환불 시 라인 단위 회수)
+func (uc *EarnPointsUseCase) EarnPointsFromPurchase(ctx context.Context, userID int64, order *point.PurchaseOrder) error {
+	if err := point.ValidateLineItems(order.Items); err != nil {
+		return err
+	}
+
+	orderID := order.OrderID
 	return uc.tm.WithTransaction(ctx, func(txCtx context.Context) error {
 		// 1. 포인트 잔액 조회
-		userPoint, err := uc.repo.GetUserPoint(txCtx, userID)
+		userPoint, err := uc.repo.GetUserPointForUpdate(txCtx, userID)
 		if err == point.ErrPointNotFound {
 			// 없으면 생성
 			userPoint = &point.UserPoint{
@@ -44,18 +53,41 @@ func (uc *EarnPointsUseCase) EarnPointsFromPurchase(ctx context.Context, userID
 			return err
 		}
 
-		// 2. 적립 포인트 계산
-		earnAmount := uc.policy.CalculateEarnPoints(paymentAmount)
+		// 2. 같은 주문으로 이미 적립했는지 확인하고, 주문 결제에 사용한 포인트 합산
+		orderTransactions, err := uc.repo.GetTransactionsByOrderID(txCtx, orderID)
+		if err != nil {
+			return err
+		}
+		var usedPoints int64
+		for _, tx := range orderTransactions {
+			if tx.IsPurchaseEarn() {
+				return point.ErrOrderAlreadyEarned
+			}
+			if tx.IsPurchaseUse() {
+				usedPoints += tx.Amount
+			}
+		}
+
+		// 3. 적립 포인트 계산 (회원 등급별 정책 적용, 포인트로 결제한 금액에는 적립하지 않음)
+		tier, policy, err := resolvePolicy(txCtx, uc.repo, uc.policy, userID)
+		if err != nil {
+			return err
+		}
+		earnableAmount := policy.EarnableAmount(order.PaymentAmount, usedPoints)
+		lineEarns, earnAmount, err := calculatePurchaseEarn(txCtx, uc.repo, policy, order, usedPoints)
+		if err != nil {
+			return err
+		}
 		if earnAmount <= 0 {
 			return nil
 		}
 
-		// 3. 포인트 적립 (도메인 로직)
-		userPoint.Earn(earnAmount)
+		// 4. 적립 예정 포인트 추가 (도메인 로직)
+		userPoint.AddPending(earnAmount)
 
-		// 4. 적립 거래 내역 생성
+		// 5. 적립 예정 거래 내역 생성 (적립일/만료일은 확정 시점에 설정)
 		now := time.Now()
-		expiresAt := uc.policy.CalculateExpiryDate(now)
+		scheduledAt := policy.CalculateEarnDate(now)
 
 		transaction := &point.Transaction{
 			UserID:       userID,
@@ -65,27 +97,83 @@ func (uc *EarnPointsUseCase) EarnPointsFromPurchase(ctx context.Context, userID
 			ReasonType:   point.ReasonTypePurchase,
 			ReasonDetail: "구매 적립",
 			OrderID:      &orderID,
-			EarnedAt:     &now,
-			ExpiresAt:    &expiresAt,
+			ScheduledAt:  &scheduledAt,
 			Expired:      false,
-			Status:       point.TransactionStatusConfirmed,
+			Status:       point.TransactionStatusPending,
 			CreatedAt:    now,
 		}
 
 		if err := uc.repo.CreateTransaction(txCtx, transaction); err != nil {
 			return err
 		}
+		if err := recordEvent(txCtx, uc.repo, point.EventTypeEarned, transaction); err != nil {
+			return err
+		}
 
-		// 5. 잔액 업데이트
+		// 6. 주문 라인별 적립 내역 저장
+		for _, earn := range lineEarns {
+			line := &point.OrderLine{
+				OrderID:      orderID,
+				UserID:       userID,
+				LineItem:     earn.Item,
+				EarnRate:     earn.Rate,
+				EarnedPoints: earn.Points,
+			}
+			if err := uc.repo.CreateOrderLine(txCtx, line); err != nil {
+				return err
+			}
+		}
+
+		// 7. 진행 중인 캠페인 추가 적립
+		if err := applyCampaigns(txCtx, uc.repo, policy, userPoint, transaction, &point.EarnContext{
+			UserID:        userID,
+			Tier:          tier,
+			ReasonType:    point.ReasonTypePurchase,
+			Categories:    order.EarnCategories(),
+			PaymentAmount: earnableAmount,
+			BaseAmount:    earnAmount,
+			At:            now,
+		}); err != nil {
+			return err
+		}
+
+		// 8. 잔액 업데이트
+		invalidateBalance(txCtx, uc.tm, uc.cache, userPoint.UserID)
 		return uc.repo.UpdateUserPoint(txCtx, userPoint)
 	})
 }
 
+// calculatePurchaseEarn 구매 적립 포인트 계산
+// 주문 라인이 있으면 카테고리/상품 규칙으로 라인별 적립을, 없으면 적립 대상 결제 금액 기준 적립을 계산
+func calculatePurchaseEarn(
+	ctx context.Context,
+	repo point.Repository,
+	policy *point.Policy,
+	order *point.PurchaseOrder,
+	usedPoints int64,
+) ([]point.LineEarn, int64, error) {
+	if len(order.Items) == 0 {
+		return nil, policy.CalculateEarnPoints(policy.EarnableAmount(order.PaymentAmount, usedPoints)), nil
+	}
+
+	rules, err := repo.GetEarnRules(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	lineEarns := policy.CalculateLineEarnPoints(order.Items, point.NewEarnRuleSet(rules), usedPoints)
+
+	var earnAmount int64
+	for _, earn := range lineEarns {
+		earnAmount += earn.Points
+	}
+	return lineEarns, earnAmount, nil
+}
+
 // EarnPointsFromReview 리뷰 적립
 func (uc *EarnPointsUseCase) EarnPointsFromReview(ctx context.Context, userID int64, isPhoto bool) error {
 	return uc.tm.WithTransaction(ctx, func(txCtx context.Context) error {
 		// 1. 포인트 잔액 조회
-		userPoint, err := uc.repo.GetUserPoint(txCtx, userID)
+		userPoint, err := uc.repo.GetUserPointForUpdate(txCtx, userID)
 		if err == point.ErrPointNotFound {
 			userPoint = &point.UserPoint{
 				UserID:           userID,
@@ -102,43 +190,57 @@ func (uc *EarnPointsUseCase) EarnPointsFromReview(ctx context.Context, userID in
 			return err
 		}
 
-		// 2. 적립 포인트 계산
+		// 2. 적립 포인트 계산 (회원 등급별 정책 적용)
+		tier, policy, err := resolvePolicy(txCtx, uc.repo, uc.policy, userID)
+		if err != nil {
+			return err
+		}
 		var earnAmount int64
 		var reasonDetail string
 		if isPhoto {
-			earnAmount = uc.policy.ReviewPhotoPoints
+			earnAmount = policy.ReviewPhotoPoints
 			reasonDetail = "포토 리뷰 적립"
 		} else {
-			earnAmount = uc.policy.ReviewTextPoints
+			earnAmount = policy.ReviewTextPoints
 			reasonDetail = "텍스트 리뷰 적립"
 		}
 
-		// 3. 포인트 적립
-		userPoint.Earn(earnAmount)
+		// 3. 포인트 적립 (부채가 있으면 먼저 상계)
+		offset := userPoint.Earn(earnAmount)
 
 		// 4. 적립 거래 내역 생성
 		now := time.Now()
-		expiresAt := uc.policy.CalculateExpiryDate(now)
 
 		transaction := &point.Transaction{
-			UserID:       userID,
-			Type:         point.TransactionTypeEarn,
-			Amount:       earnAmount,
-			BalanceAfter: userPoint.AvailableBalance,
-			ReasonType:   point.ReasonTypeReview,
-			ReasonDetail: reasonDetail,
-			EarnedAt:     &now,
-			ExpiresAt:    &expiresAt,
-			Expired:      false,
-			Status:       point.TransactionStatusConfirmed,
-			CreatedAt:    now,
+			UserID:          userID,
+			Type:            point.TransactionTypeEarn,
+			Amount:          earnAmount,
+			RemainingAmount: earnAmount - offset,
+			BalanceAfter:    userPoint.AvailableBalance,
+			ReasonType:      point.ReasonTypeReview,
+			ReasonDetail:    reasonDetail,
+			Wallet:          point.DefaultWallet,
+			EarnedAt:        &now,
+			ExpiresAt:       policy.CalculateWalletExpiry(point.DefaultWallet, now),
+			Expired:         false,
+			Status:          point.TransactionStatusConfirmed,
+			CreatedAt:       now,
 		}
 
 		if err := uc.repo.CreateTransaction(txCtx, transaction); err != nil {
 			return err
 		}
+		if err := recordEvent(txCtx, uc.repo, point.EventTypeEarned, transaction); err != nil {
+			return err
+		}
 
-		// 5. 잔액 업데이트
+		// 5. 진행 중인 캠페인 추가 적립
+		if err := uc.applyCampaigns(txCtx, policy, tier, userPoint, transaction, earnAmount); err != nil {
+			return err
+		}
+
+		// 6. 잔액 업데이트
+		invalidateBalance(txCtx, uc.tm, uc.cache, userPoint.UserID)
 		return uc.repo.UpdateUserPoint(txCtx, userPoint)
 	})
 }
@@ -147,7 +249,7 @@ func (uc *EarnPointsUseCase) EarnPointsFromReview(ctx context.Context, userID in
 func (uc *EarnPointsUseCase) EarnSignupBonus(ctx context.Context, userID int64) error {
 	return uc.tm.WithTransaction(ctx, func(txCtx context.Context) error {
 		// 1. 포인트 잔액 조회
-		userPoint, err := uc.repo.GetUserPoint(txCtx, userID)
+		userPoint, err := uc.repo.GetUserPointForUpdate(txCtx, userID)
 		if err == point.ErrPointNotFound {
 			userPoint = &point.UserPoint{
 				UserID:           userID,
@@ -164,33 +266,58 @@ func (uc *EarnPointsUseCase) EarnSignupBonus(ctx context.Context, userID int64)
 			return err
 		}
 
-		// 2. 가입 보너스 적립
-		earnAmount := uc.policy.SignupBonus
-		userPoint.Earn(earnAmount)
+		// 2. 가입 보너스 적립 (회원 등급별 정책 적용, 부채가 있으면 먼저 상계)
+		tier, policy, err := resolvePolicy(txCtx, uc.repo, uc.policy, userID)
+		if err != nil {
+			return err
+		}
+		earnAmount := policy.SignupBonus
+		offset := userPoint.Earn(earnAmount)
 
 		// 3. 적립 거래 내역 생성
 		now := time.Now()
-		expiresAt := uc.policy.CalculateExpiryDate(now)
 
 		transaction := &point.Transaction{
-			UserID:       userID,
-			Type:         point.TransactionTypeEarn,
-			Amount:       earnAmount,
-			BalanceAfter: userPoint.AvailableBalance,
-			ReasonType:   point.ReasonTypeSignup,
-			ReasonDetail: "가입 보너스",
-			EarnedAt:     &now,
-			ExpiresAt:    &expiresAt,
-			Expired:      false,
-			Status:       point.TransactionStatusConfirmed,
-			CreatedAt:    now,
+			UserID:          userID,
+			Type:            point.TransactionTypeEarn,
+			Amount:          earnAmount,
+			RemainingAmount: earnAmount - offset,
+			BalanceAfter:    userPoint.AvailableBalance,
+			ReasonType:      point.ReasonTypeSignup,
+			ReasonDetail:    "가입 보너스",
+			Wallet:          point.DefaultWallet,
+			EarnedAt:        &now,
+			ExpiresAt:       policy.CalculateWalletExpiry(point.DefaultWallet, now),
+			Expired:         false,
+			Status:          point.TransactionStatusConfirmed,
+			CreatedAt:       now,
 		}
 
 		if err := uc.repo.CreateTransaction(txCtx, transaction); err != nil {
 			return err
 		}
+		if err := recordEvent(txCtx, uc.repo, point.EventTypeEarned, transaction); err != nil {
+			return err
+		}
 
-		// 4. 잔액 업데이트
+		// 4. 진행 중인 캠페인 추가 적립
+		if err := uc.applyCampaigns(txCtx, policy, tier, userPoint, transaction, earnAmount); err != nil {
+			return err
+		}
+
+		// 5. 잔액 업데이트
+		invalidateBalance(txCtx, uc.tm, uc.cache, userPoint.UserID)
 		return uc.repo.UpdateUserPoint(txCtx, userPoint)
 	})
 }
+
+// applyCampaigns 결제 없는 적립(리뷰/가입)의 캠페인 추가 적립 (회원 등급별 정책 적용)
+func (uc *EarnPointsUseCase) applyCampaigns(ctx context.Context, policy *point.Policy, tier point.Tier, userPoint *point.UserPoint, base *point.Transaction, earnAmount int64) error {
+	return applyCampaigns(ctx, uc.repo, policy, userPoint, base, &point.EarnContext{
+		UserID:     userPoint.UserID,
+		Tier:       tier,
+		ReasonType: base.ReasonType,
+		BaseAmount: earnAmount,
+		At:         base.CreatedAt,
+	})
+}
@@ -0,0 +1,170 @@
+package point
+
+import (
+	"context"
+	"fmt"
+	"shopping-mall/internal/domain/point"
+	"shopping-mall/internal/repository/redis"
+	"time"
+)
+
+// EvaluateGradesUseCase 구매 실적 기반 회원 등급 산정 유스케이스
+type EvaluateGradesUseCase struct {
+	repo        point.Repository
+	tm          point.TransactionManager
+	policy      *point.Policy
+	gradePolicy *point.GradePolicy
+	cache       *redis.PointCache
+}
+
+// NewEvaluateGradesUseCase 회원 등급 산정 유스케이스 생성
+func NewEvaluateGradesUseCase(repo point.Repository, tm point.TransactionManager, policy *point.Policy, gradePolicy *point.GradePolicy, cache *redis.PointCache) *EvaluateGradesUseCase {
+	return &EvaluateGradesUseCase{
+		repo:        repo,
+		tm:          tm,
+		policy:      policy,
+		gradePolicy: gradePolicy,
+		cache:       cache,
+	}
+}
+
+// GradeResult 전체 등급 산정 결과
+type GradeResult struct {
+	Checked    int // 산정한 사용자 수
+	Upgraded   int // 상향된 사용자 수
+	Downgraded int // 하향된 사용자 수
+	Bonuses    int // 승급 보너스를 지급한 사용자 수
+	Failed     int // 산정에 실패한 사용자 수
+}
+
+// EvaluateUser 사용자 등급 산정 (등급이 바뀌면 변경 이력 반환)
+// awardBonus 이면 승급한 등급의 보너스를 등급별 최초 1회 지급
+func (uc *EvaluateGradesUseCase) EvaluateUser(ctx context.Context, userID int64, now time.Time, awardBonus bool) (*point.TierHistory, error) {
+	// 1. 구매 실적 집계 (트랜잭션 밖에서 조회, 읽기 복제본 사용)
+	spend, err := uc.repo.GetPurchaseSpend(ctx, userID, uc.gradePolicy.WindowStart(now))
+	if err != nil {
+		return nil, err
+	}
+	target := uc.gradePolicy.TierFor(spend)
+
+	var history *point.TierHistory
+	err = uc.tm.WithTransaction(ctx, func(txCtx context.Context) error {
+		// 2. 현재 등급 조회 (FOR UPDATE 락)
+		userTier, err := uc.repo.GetUserTierForUpdate(txCtx, userID)
+		if err != nil {
+			return err
+		}
+
+		// 3. 등급 산정 (상향 즉시, 하향은 유예 기간 후)
+		history = userTier.Evaluate(target, spend, now, uc.gradePolicy.DowngradeGraceDays)
+		if err := uc.repo.SaveUserTier(txCtx, userTier); err != nil {
+			return err
+		}
+		if history == nil {
+			return nil
+		}
+
+		// 4. 승급 보너스 지급
+		if awardBonus && history.IsUpgrade() {
+			if err := uc.awardBonus(txCtx, history, now); err != nil {
+				return err
+			}
+		}
+
+		// 5. 등급 변경 이력 기록
+		return uc.repo.CreateTierHistory(txCtx, history)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+// EvaluateAll 전체 사용자를 batchSize 단위로 등급 산정 (사용자마다 별도 트랜잭션)
+func (uc *EvaluateGradesUseCase) EvaluateAll(ctx context.Context, batchSize int, awardBonus bool) (*GradeResult, error) {
+	result := &GradeResult{}
+	now := time.Now()
+	var afterUserID int64
+	for {
+		userIDs, err := uc.repo.GetUserIDsAfter(ctx, afterUserID, batchSize)
+		if err != nil {
+			return result, err
+		}
+		if len(userIDs) == 0 {
+			return result, nil
+		}
+
+		for _, userID := range userIDs {
+			if err := ctx.Err(); err != nil {
+				return result, err
+			}
+
+			result.Checked++
+			history, err := uc.EvaluateUser(ctx, userID, now, awardBonus)
+			if err != nil {
+				result.Failed++
+				continue
+			}
+			if history == nil {
+				continue
+			}
+			if history.IsUpgrade() {
+				result.Upgraded++
+			} else {
+				result.Downgraded++
+			}
+			if history.BonusTransactionID != nil {
+				result.Bonuses++
+			}
+		}
+
+		afterUserID = userIDs[len(userIDs)-1]
+	}
+}
+
+// awardBonus 승급 보너스 적립 (같은 등급 보너스는 최초 1회만 지급)
+func (uc *EvaluateGradesUseCase) awardBonus(ctx context.Context, history *point.TierHistory, now time.Time) error {
+	bonus := uc.gradePolicy.UpgradeBonus(history.ToTier)
+	if bonus <= 0 {
+		return nil
+	}
+
+	awarded, err := uc.repo.HasTierBonus(ctx, history.UserID, history.ToTier)
+	if err != nil || awarded {
+		return err
+	}
+
+	// 포인트 잔액 조회 (FOR UPDATE 락)
+	userPoint, err := uc.repo.GetUserPointForUpdate(ctx, history.UserID)
+	if err != nil {
+		return err
+	}
+
+	// 포인트 적립 (부채가 있으면 먼저 상계)
+	offset := userPoint.Earn(bonus)
+
+	expiresAt := uc.policy.CalculateExpiryDate(now)
+	transaction := &point.Transaction{
+		UserID:          history.UserID,
+		Type:            point.TransactionTypeEarn,
+		Amount:          bonus,
+		RemainingAmount: bonus - offset,
+		BalanceAfter:    userPoint.AvailableBalance,
+		ReasonType:      point.ReasonTypeGrade,
+		ReasonDetail:    fmt.Sprintf("%s 등급 승급 보너스", history.ToTier),
+		EarnedAt:        &now,
+		ExpiresAt:       &expiresAt,
+		Status:          point.TransactionStatusConfirmed,
+		CreatedAt:       now,
+	}
+	if err := uc.repo.CreateTransaction(ctx, transaction); err != nil {
+		return err
+	}
+	if err := recordEvent(ctx, uc.repo, point.EventTypeEarned, transaction); err != nil {
+		return err
+	}
+	history.BonusTransactionID = &transaction.ID
+
+	invalidateBalance(ctx, uc.tm, uc.cache, userPoint.UserID)
+	return uc.repo.UpdateUserPoint(ctx, userPoint)
+}
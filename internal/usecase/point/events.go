@@ -0,0 +1,57 @@
+package point
+
+import (
+	"context"
+	"encoding/json"
+	"shopping-mall/internal/domain/point"
+	"time"
+)
+
+// transactionEventPayload 거래 내역 기반 도메인 이벤트 페이로드
+type transactionEventPayload struct {
+	EventID         string                  `json:"event_id"`
+	EventType       point.EventType         `json:"event_type"`
+	OccurredAt      time.Time               `json:"occurred_at"`
+	UserID          int64                   `json:"user_id"`
+	TransactionID   int64                   `json:"transaction_id"`
+	TransactionType point.TransactionType   `json:"transaction_type"`
+	Amount          int64                   `json:"amount"`
+	BalanceAfter    int64                   `json:"balance_after"`
+	ReasonType      point.ReasonType        `json:"reason_type"`
+	ReasonDetail    string                  `json:"reason_detail"`
+	Status          point.TransactionStatus `json:"status"`
+	Wallet          point.Wallet            `json:"wallet,omitempty"`
+	OrderID         *int64                  `json:"order_id,omitempty"`
+	CampaignID      *int64                  `json:"campaign_id,omitempty"`
+	ExpiresAt       *time.Time              `json:"expires_at,omitempty"`
+}
+
+// recordEvent 거래 내역으로 도메인 이벤트를 만들어 outbox 에 저장
+// 호출한 유스케이스와 같은 트랜잭션에 저장되므로 거래가 롤백되면 이벤트도 함께 롤백
+func recordEvent(ctx context.Context, repo point.Repository, eventType point.EventType, tx *point.Transaction) error {
+	event := point.NewOutboxEvent(eventType, tx.UserID, &tx.ID, time.Now())
+
+	payload, err := json.Marshal(&transactionEventPayload{
+		EventID:         event.EventID,
+		EventType:       eventType,
+		OccurredAt:      event.CreatedAt,
+		UserID:          tx.UserID,
+		TransactionID:   tx.ID,
+		TransactionType: tx.Type,
+		Amount:          tx.Amount,
+		BalanceAfter:    tx.BalanceAfter,
+		ReasonType:      tx.ReasonType,
+		ReasonDetail:    tx.ReasonDetail,
+		Status:          tx.Status,
+		Wallet:          tx.Wallet,
+		OrderID:         tx.OrderID,
+		CampaignID:      tx.CampaignID,
+		ExpiresAt:       tx.ExpiresAt,
+	})
+	if err != nil {
+		return err
+	}
+	event.Payload = payload
+
+	return repo.CreateOutboxEvent(ctx, event)
+}
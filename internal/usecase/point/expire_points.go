@@ -2,86 +2,148 @@ package point
 
 import (
 	"context"
+	"fmt"
 	"shopping-mall/internal/domain/point"
+	"shopping-mall/internal/repository/redis"
 	"time"
 )
 
 // ExpirePointsUseCase 포인트 만료 유스케이스
 type ExpirePointsUseCase struct {
-	repo point.Repository
-	tm   point.TransactionManager
+	repo  point.Repository
+	tm    point.TransactionManager
+	cache *redis.PointCache
 }
 
 // NewExpirePointsUseCase 포인트 만료 유스케이스 생성
-func NewExpirePointsUseCase(repo point.Repository, tm point.TransactionManager) *ExpirePointsUseCase {
+func NewExpirePointsUseCase(repo point.Repository, tm point.TransactionManager, cache *redis.PointCache) *ExpirePointsUseCase {
 	return &ExpirePointsUseCase{
-		repo: repo,
-		tm:   tm,
+		repo:  repo,
+		tm:    tm,
+		cache: cache,
 	}
 }
 
+// ExpireResult 포인트 만료 배치 결과
+type ExpireResult struct {
+	Checked int   // 만료 대상 사용자 수
+	Expired int   // 포인트를 만료한 사용자 수
+	Amount  int64 // 만료한 포인트 합계
+	Failed  int   // 만료 처리에 실패한 사용자 수
+}
+
 // ExpirePoints 만료 포인트 처리
-func (uc *ExpirePointsUseCase) ExpirePoints(ctx context.Context, before time.Time, limit int) error {
-	return uc.tm.WithTransaction(ctx, func(txCtx context.Context) error {
-		// 1. 만료 대상 조회
-		transactions, err := uc.repo.GetExpiringTransactions(txCtx, before, limit)
+// 사용자마다 별도 트랜잭션으로 처리하여 여러 사용자의 락을 한 번에 잡지 않고, 실패한 사용자는 건너뛴 뒤 첫 오류를 반환
+func (uc *ExpirePointsUseCase) ExpirePoints(ctx context.Context, before time.Time, limit int) (*ExpireResult, error) {
+	result := &ExpireResult{}
+
+	// 1. 만료 대상 사용자 조회 (락 없음)
+	userIDs, err := uc.repo.GetExpiringUserIDs(ctx, before, limit)
+	if err != nil {
+		return result, err
+	}
+
+	// 2. 사용자별 만료 처리
+	var firstErr error
+	for _, userID := range userIDs {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		result.Checked++
+		amount, err := uc.ExpireUser(ctx, userID, before)
+		if err != nil {
+			result.Failed++
+			if firstErr == nil {
+				firstErr = fmt.Errorf("failed to expire points of user %d: %w", userID, err)
+			}
+			continue
+		}
+		if amount > 0 {
+			result.Expired++
+			result.Amount += amount
+		}
+	}
+
+	return result, firstErr
+}
+
+// ExpireUser 사용자의 만료 대상 lot 잔여 포인트 만료 (만료한 포인트 합계 반환)
+// 사용자 포인트를 먼저 잠근 뒤 lot 을 FOR UPDATE 로 다시 읽어, 동시에 사용/회수된 포인트를 만료하지 않음
+func (uc *ExpirePointsUseCase) ExpireUser(ctx context.Context, userID int64, before time.Time) (int64, error) {
+	var total int64
+	err := uc.tm.WithTransaction(ctx, func(txCtx context.Context) error {
+		// 재시도 시 처음부터 다시 집계
+		total = 0
+
+		// 1. 포인트 잔액 조회 (FOR UPDATE 락)
+		userPoint, err := uc.repo.GetUserPointForUpdate(txCtx, userID)
+		if err == point.ErrPointNotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// 2. 만료 대상 lot 재조회 (FOR UPDATE 락)
+		transactions, err := uc.repo.GetExpiringTransactionsForUpdate(txCtx, userID, before)
 		if err != nil {
 			return err
 		}
 
-		// 2. 사용자별로 그룹화
-		userTransactions := make(map[int64][]*point.Transaction)
+		// 3. 만료 포인트 계산 (lot 의 미사용 잔여 포인트만 지갑별로 만료)
+		expireAmounts := make(map[point.Wallet]int64)
 		for _, tx := range transactions {
-			userTransactions[tx.UserID] = append(userTransactions[tx.UserID], tx)
+			expireAmounts[tx.Wallet.OrDefault()] += tx.Consume(tx.RemainingAmount)
+			tx.Expired = true
+			if err := uc.repo.UpdateTransaction(txCtx, tx); err != nil {
+				return err
+			}
 		}
 
-		// 3. 사용자별로 만료 처리
-		for userID, txs := range userTransactions {
-			// 포인트 잔액 조회
-			userPoint, err := uc.repo.GetUserPoint(txCtx, userID)
-			if err != nil {
+		now := time.Now()
+		for _, wallet := range point.Wallets {
+			expireAmount := expireAmounts[wallet]
+			if expireAmount <= 0 {
 				continue
 			}
 
-			// 만료 포인트 계산
-			var expireAmount int64
-			for _, tx := range txs {
-				if !tx.Expired {
-					expireAmount += tx.Amount
-					tx.Expired = true
-					if err := uc.repo.UpdateTransaction(txCtx, tx); err != nil {
-						return err
-					}
-				}
+			// 4. 포인트 만료 (잔액이 부족하면 잔액과 lot 이 어긋난 것이므로 롤백)
+			if err := userPoint.Expire(expireAmount); err != nil {
+				return err
 			}
+			total += expireAmount
 
-			if expireAmount > 0 {
-				// 포인트 만료
-				userPoint.Expire(expireAmount)
-
-				// 만료 거래 내역 생성
-				transaction := &point.Transaction{
-					UserID:       userID,
-					Type:         point.TransactionTypeExpire,
-					Amount:       expireAmount,
-					BalanceAfter: userPoint.AvailableBalance,
-					ReasonType:   point.ReasonTypeAdmin,
-					ReasonDetail: "포인트 만료",
-					Status:       point.TransactionStatusConfirmed,
-					CreatedAt:    time.Now(),
-				}
-
-				if err := uc.repo.CreateTransaction(txCtx, transaction); err != nil {
-					return err
-				}
-
-				// 잔액 업데이트
-				if err := uc.repo.UpdateUserPoint(txCtx, userPoint); err != nil {
-					return err
-				}
+			// 5. 지갑별 만료 거래 내역 생성
+			transaction := &point.Transaction{
+				UserID:       userID,
+				Type:         point.TransactionTypeExpire,
+				Amount:       expireAmount,
+				BalanceAfter: userPoint.AvailableBalance,
+				ReasonType:   point.ReasonTypeAdmin,
+				ReasonDetail: "포인트 만료",
+				Wallet:       wallet,
+				Status:       point.TransactionStatusConfirmed,
+				CreatedAt:    now,
+			}
+			if err := uc.repo.CreateTransaction(txCtx, transaction); err != nil {
+				return err
+			}
+			if err := recordEvent(txCtx, uc.repo, point.EventTypeExpired, transaction); err != nil {
+				return err
 			}
 		}
 
-		return nil
+		if total == 0 {
+			return nil
+		}
+
+		// 6. 잔액 업데이트
+		invalidateBalance(txCtx, uc.tm, uc.cache, userPoint.UserID)
+		return uc.repo.UpdateUserPoint(txCtx, userPoint)
 	})
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
 }
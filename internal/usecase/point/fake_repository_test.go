@@ -0,0 +1,139 @@
+package point
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"shopping-mall/internal/domain/point"
+)
+
+// fakeRepository 테스트용 인메모리 저장소 (테스트에서 쓰는 메서드만 구현)
+type fakeRepository struct {
+	point.Repository
+
+	lots          map[int64]*point.Transaction
+	subscriptions map[int64]*point.WebhookSubscription
+	deliveries    map[int64]*point.WebhookDelivery
+	campaigns     []*point.Campaign
+	userBonuses   map[int64]int64 // 캠페인 ID 별 사용자 추가 적립 합계
+	lotQueries    int
+}
+
+func newFakeRepository() *fakeRepository {
+	return &fakeRepository{
+		lots:          make(map[int64]*point.Transaction),
+		subscriptions: make(map[int64]*point.WebhookSubscription),
+		deliveries:    make(map[int64]*point.WebhookDelivery),
+		userBonuses:   make(map[int64]int64),
+	}
+}
+
+func (r *fakeRepository) addLot(userID int64, wallet point.Wallet, remaining int64, expiresAt time.Time) *point.Transaction {
+	lot := &point.Transaction{
+		ID:              int64(len(r.lots) + 1),
+		UserID:          userID,
+		Type:            point.TransactionTypeEarn,
+		Amount:          remaining,
+		RemainingAmount: remaining,
+		Wallet:          wallet,
+		ExpiresAt:       &expiresAt,
+	}
+	r.lots[lot.ID] = lot
+	return lot
+}
+
+// GetEarnedTransactions 저장된 값의 복사본을 반환 (UpdateTransaction 전까지는 저장소에 반영되지 않음)
+func (r *fakeRepository) GetEarnedTransactions(ctx context.Context, userID int64, walletOrder []point.Wallet, limit, offset int) ([]*point.Transaction, error) {
+	r.lotQueries++
+
+	rank := make(map[point.Wallet]int, len(walletOrder))
+	for i, wallet := range walletOrder {
+		rank[wallet] = i
+	}
+
+	var lots []*point.Transaction
+	for _, lot := range r.lots {
+		if lot.UserID == userID && lot.RemainingAmount > 0 && !lot.IsExpired() {
+			copied := *lot
+			lots = append(lots, &copied)
+		}
+	}
+	sort.Slice(lots, func(i, j int) bool {
+		if rank[lots[i].Wallet] != rank[lots[j].Wallet] {
+			return rank[lots[i].Wallet] < rank[lots[j].Wallet]
+		}
+		if !lots[i].ExpiresAt.Equal(*lots[j].ExpiresAt) {
+			return lots[i].ExpiresAt.Before(*lots[j].ExpiresAt)
+		}
+		return lots[i].ID < lots[j].ID
+	})
+
+	if offset >= len(lots) {
+		return nil, nil
+	}
+	lots = lots[offset:]
+	if len(lots) > limit {
+		lots = lots[:limit]
+	}
+	return lots, nil
+}
+
+func (r *fakeRepository) UpdateTransaction(ctx context.Context, tx *point.Transaction) error {
+	copied := *tx
+	r.lots[tx.ID] = &copied
+	return nil
+}
+
+func (r *fakeRepository) GetWebhookSubscription(ctx context.Context, id int64) (*point.WebhookSubscription, error) {
+	subscription, ok := r.subscriptions[id]
+	if !ok {
+		return nil, point.ErrWebhookNotFound
+	}
+	return subscription, nil
+}
+
+func (r *fakeRepository) GetWebhookDelivery(ctx context.Context, id int64) (*point.WebhookDelivery, error) {
+	delivery, ok := r.deliveries[id]
+	if !ok {
+		return nil, point.ErrWebhookDeliveryNotFound
+	}
+	copied := *delivery
+	return &copied, nil
+}
+
+func (r *fakeRepository) GetDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]*point.WebhookDelivery, error) {
+	var deliveries []*point.WebhookDelivery
+	for _, delivery := range r.deliveries {
+		if delivery.Status == point.WebhookDeliveryPending && !delivery.NextAttemptAt.After(now) {
+			copied := *delivery
+			deliveries = append(deliveries, &copied)
+		}
+	}
+	sort.Slice(deliveries, func(i, j int) bool { return deliveries[i].ID < deliveries[j].ID })
+	if len(deliveries) > limit {
+		deliveries = deliveries[:limit]
+	}
+	return deliveries, nil
+}
+
+func (r *fakeRepository) UpdateWebhookDelivery(ctx context.Context, delivery *point.WebhookDelivery) error {
+	copied := *delivery
+	r.deliveries[delivery.ID] = &copied
+	return nil
+}
+
+func (r *fakeRepository) GetActiveCampaigns(ctx context.Context, at time.Time) ([]*point.Campaign, error) {
+	var active []*point.Campaign
+	for _, campaign := range r.campaigns {
+		if campaign.IsActive(at) {
+			copied := *campaign
+			active = append(active, &copied)
+		}
+	}
+	return active, nil
+}
+
+func (r *fakeRepository) GetCampaignUserBonus(ctx context.Context, campaignID, userID int64) (int64, error) {
+	return r.userBonuses[campaignID], nil
+}
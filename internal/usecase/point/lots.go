@@ -0,0 +1,150 @@
+package point
+
+import (
+	"context"
+	"shopping-mall/internal/domain/point"
+)
+
+// lotPageSize 적립 lot 을 한 번에 조회할 개수 (차감할 금액이 채워질 때까지 다음 페이지 조회)
+const lotPageSize = 100
+
+// lotConsumption FIFO 방식 적립 lot 차감 계획
+type lotConsumption struct {
+	lots        []*point.Transaction
+	allocations []*point.Allocation
+}
+
+// planLotConsumption 지갑 사용 순서대로, 같은 지갑에서는 만료일이 가까운 적립 lot 부터 amount 만큼 차감할 계획 수립
+func planLotConsumption(ctx context.Context, repo point.Repository, userID, amount int64, walletOrder []point.Wallet) (*lotConsumption, error) {
+	plan := &lotConsumption{}
+	remainingAmount := amount
+	err := forEachLot(ctx, repo, userID, walletOrder, func(tx *point.Transaction) bool {
+		consumed := tx.Consume(remainingAmount)
+		if consumed > 0 {
+			plan.allocations = append(plan.allocations, &point.Allocation{
+				UserID:            userID,
+				EarnTransactionID: tx.ID,
+				Amount:            consumed,
+			})
+			plan.lots = append(plan.lots, tx)
+			remainingAmount -= consumed
+		}
+		return remainingAmount <= 0
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if remainingAmount > 0 {
+		return nil, point.ErrInsufficientPoints
+	}
+
+	return plan, nil
+}
+
+// forEachLot 지갑 사용 순서대로 적립 lot 을 페이지 단위로 조회하며 fn 이 true 를 반환할 때까지 순회
+// 순회 중에는 lot 을 저장하지 않아야 페이지 경계가 바뀌지 않음 (변경한 lot 은 순회가 끝난 뒤 저장)
+func forEachLot(ctx context.Context, repo point.Repository, userID int64, walletOrder []point.Wallet, fn func(*point.Transaction) bool) error {
+	for offset := 0; ; offset += lotPageSize {
+		lots, err := repo.GetEarnedTransactions(ctx, userID, walletOrder, lotPageSize, offset)
+		if err != nil {
+			return err
+		}
+		for _, lot := range lots {
+			if fn(lot) {
+				return nil
+			}
+		}
+		if len(lots) < lotPageSize {
+			return nil
+		}
+	}
+}
+
+// save 적립 lot 잔여 포인트 차감 및 사용 거래에 대한 차감 내역 기록
+func (c *lotConsumption) save(ctx context.Context, repo point.Repository, useTransactionID int64) error {
+	for i, lot := range c.lots {
+		if err := repo.UpdateTransaction(ctx, lot); err != nil {
+			return err
+		}
+
+		c.allocations[i].UseTransactionID = useTransactionID
+		if err := repo.CreateAllocation(ctx, c.allocations[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreAllocations 사용 거래가 차감했던 적립 lot 에 최대 limit 만큼 포인트를 복구
+// lot 에 실제 복구된 금액과 처리된 차감 내역 금액을 반환
+// 만료/취소된 lot 은 복구하지 않지만 차감 내역은 복구 완료로 처리 (나머지는 호출자가 처리)
+func restoreAllocations(ctx context.Context, repo point.Repository, useTx *point.Transaction, limit int64) (restored, processed int64, err error) {
+	allocations, err := repo.GetAllocationsByUseTransactionID(ctx, useTx.ID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, allocation := range allocations {
+		if processed >= limit {
+			break
+		}
+
+		amount := allocation.Restorable()
+		if amount > limit-processed {
+			amount = limit - processed
+		}
+		if amount <= 0 {
+			continue
+		}
+		processed += amount
+
+		lot, err := repo.GetTransactionByID(ctx, allocation.EarnTransactionID)
+		if err != nil {
+			return 0, 0, err
+		}
+		if lot.CanRestore() {
+			lot.Restore(amount)
+			if err := repo.UpdateTransaction(ctx, lot); err != nil {
+				return 0, 0, err
+			}
+			restored += amount
+		}
+
+		allocation.Restore(amount)
+		if err := repo.UpdateAllocation(ctx, allocation); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return restored, processed, nil
+}
+
+// consumeLots 지갑 사용 순서대로 적립 lot 에서 최대 amount 만큼 잔여 포인트 차감 (회수용, 차감 내역 없음)
+// 실제 차감된 금액을 반환
+func consumeLots(ctx context.Context, repo point.Repository, userID, amount int64, walletOrder []point.Wallet) (int64, error) {
+	if amount <= 0 {
+		return 0, nil
+	}
+
+	var consumed int64
+	var touched []*point.Transaction
+	err := forEachLot(ctx, repo, userID, walletOrder, func(tx *point.Transaction) bool {
+		if taken := tx.Consume(amount - consumed); taken > 0 {
+			touched = append(touched, tx)
+			consumed += taken
+		}
+		return consumed >= amount
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, tx := range touched {
+		if err := repo.UpdateTransaction(ctx, tx); err != nil {
+			return 0, err
+		}
+	}
+
+	return consumed, nil
+}
@@ -0,0 +1,143 @@
+package point
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"shopping-mall/internal/domain/point"
+)
+
+func TestPlanLotConsumption(t *testing.T) {
+	ctx := context.Background()
+	soon := time.Now().Add(24 * time.Hour)
+	later := time.Now().Add(48 * time.Hour)
+	walletOrder := []point.Wallet{point.WalletEvent, point.WalletRegular, point.WalletCash}
+
+	tests := []struct {
+		name      string
+		setup     func(r *fakeRepository)
+		amount    int64
+		wantErr   error
+		wantLots  []int64 // 차감되는 lot ID 순서
+		wantTaken []int64
+	}{
+		{
+			name: "wallet order before expiry",
+			setup: func(r *fakeRepository) {
+				r.addLot(1, point.WalletRegular, 1000, soon) // 1
+				r.addLot(1, point.WalletEvent, 300, later)   // 2
+			},
+			amount:    500,
+			wantLots:  []int64{2, 1},
+			wantTaken: []int64{300, 200},
+		},
+		{
+			name: "nearest expiry first within wallet",
+			setup: func(r *fakeRepository) {
+				r.addLot(1, point.WalletRegular, 1000, later) // 1
+				r.addLot(1, point.WalletRegular, 400, soon)   // 2
+			},
+			amount:    600,
+			wantLots:  []int64{2, 1},
+			wantTaken: []int64{400, 200},
+		},
+		{
+			name: "skips past-due lots",
+			setup: func(r *fakeRepository) {
+				r.addLot(1, point.WalletEvent, 1000, time.Now().Add(-time.Hour)) // 1
+				r.addLot(1, point.WalletRegular, 500, soon)                      // 2
+			},
+			amount:    500,
+			wantLots:  []int64{2},
+			wantTaken: []int64{500},
+		},
+		{
+			name: "other users lots are not used",
+			setup: func(r *fakeRepository) {
+				r.addLot(2, point.WalletRegular, 1000, soon)
+				r.addLot(1, point.WalletRegular, 100, soon)
+			},
+			amount:  500,
+			wantErr: point.ErrInsufficientPoints,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeRepository()
+			tt.setup(repo)
+
+			plan, err := planLotConsumption(ctx, repo, 1, tt.amount, walletOrder)
+			if err != tt.wantErr {
+				t.Fatalf("planLotConsumption() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if len(plan.allocations) != len(tt.wantLots) {
+				t.Fatalf("allocations = %d, want %d", len(plan.allocations), len(tt.wantLots))
+			}
+			for i, allocation := range plan.allocations {
+				if allocation.EarnTransactionID != tt.wantLots[i] || allocation.Amount != tt.wantTaken[i] {
+					t.Errorf("allocation %d = lot %d amount %d, want lot %d amount %d",
+						i, allocation.EarnTransactionID, allocation.Amount, tt.wantLots[i], tt.wantTaken[i])
+				}
+			}
+		})
+	}
+}
+
+func TestPlanLotConsumptionPagesThroughLots(t *testing.T) {
+	repo := newFakeRepository()
+	expiresAt := time.Now().Add(24 * time.Hour)
+	for i := 0; i < lotPageSize*2+50; i++ {
+		repo.addLot(1, point.WalletRegular, 10, expiresAt)
+	}
+
+	amount := int64(lotPageSize*2+10) * 10
+	plan, err := planLotConsumption(context.Background(), repo, 1, amount, point.Wallets)
+	if err != nil {
+		t.Fatalf("planLotConsumption() error = %v", err)
+	}
+	if len(plan.allocations) != lotPageSize*2+10 {
+		t.Errorf("allocations = %d, want %d", len(plan.allocations), lotPageSize*2+10)
+	}
+	if repo.lotQueries != 3 {
+		t.Errorf("lot pages fetched = %d, want 3", repo.lotQueries)
+	}
+
+	if _, err := planLotConsumption(context.Background(), repo, 1, int64(lotPageSize*2+51)*10, point.Wallets); err != point.ErrInsufficientPoints {
+		t.Errorf("planLotConsumption() over balance error = %v, want %v", err, point.ErrInsufficientPoints)
+	}
+}
+
+func TestConsumeLots(t *testing.T) {
+	repo := newFakeRepository()
+	expiresAt := time.Now().Add(24 * time.Hour)
+	for i := 0; i < lotPageSize+20; i++ {
+		repo.addLot(1, point.WalletRegular, 10, expiresAt)
+	}
+
+	consumed, err := consumeLots(context.Background(), repo, 1, int64(lotPageSize+10)*10, point.Wallets)
+	if err != nil {
+		t.Fatalf("consumeLots() error = %v", err)
+	}
+	if consumed != int64(lotPageSize+10)*10 {
+		t.Errorf("consumed = %d, want %d", consumed, (lotPageSize+10)*10)
+	}
+
+	var remaining int64
+	for _, lot := range repo.lots {
+		remaining += lot.RemainingAmount
+	}
+	if remaining != 100 {
+		t.Errorf("remaining after consume = %d, want 100", remaining)
+	}
+
+	// 잔여 포인트보다 많이 회수하면 남은 만큼만 차감
+	consumed, err = consumeLots(context.Background(), repo, 1, 1000, point.Wallets)
+	if err != nil || consumed != 100 {
+		t.Errorf("consumeLots() over balance = %d, %v, want 100", consumed, err)
+	}
+}
@@ -0,0 +1,302 @@
+package point
+
+import (
+	"context"
+	"shopping-mall/internal/domain/point"
+	"time"
+)
+
+// RefundPartial 부분 환불 (환불 결제 금액 비율만큼 사용 포인트 복구 / 적립 포인트 회수)
+// refundPointAmount 가 nil 이면 사용 포인트를 환불 결제 금액 비율로 복구
+// lines 가 있으면 환불 라인에 적립된 포인트만큼 회수 (없으면 남은 결제 금액 비율로 회수)
+func (uc *RefundPointsUseCase) RefundPartial(
+	ctx context.Context,
+	userID, orderID int64,
+	paymentAmount, refundPaymentAmount int64,
+	refundPointAmount *int64,
+	lines []point.RefundLine,
+) (*point.OrderRefund, error) {
+	var refund *point.OrderRefund
+	err := uc.tm.WithTransaction(ctx, func(txCtx context.Context) error {
+		// 1. 포인트 잔액 조회
+		userPoint, err := uc.repo.GetUserPointForUpdate(txCtx, userID)
+		if err != nil {
+			return err
+		}
+
+		// 2. 부분 환불 처리
+		refund, err = uc.refundPartial(txCtx, userPoint, orderID, paymentAmount, refundPaymentAmount, refundPointAmount, lines)
+		if err != nil {
+			return err
+		}
+
+		// 3. 잔액 업데이트
+		invalidateBalance(txCtx, uc.tm, uc.cache, userPoint.UserID)
+		return uc.repo.UpdateUserPoint(txCtx, userPoint)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return refund, nil
+}
+
+// refundPartial 부분 환불 처리 (트랜잭션 및 사용자 락은 호출자가 보장)
+func (uc *RefundPointsUseCase) refundPartial(
+	ctx context.Context,
+	userPoint *point.UserPoint,
+	orderID int64,
+	paymentAmount, refundPaymentAmount int64,
+	refundPointAmount *int64,
+	lines []point.RefundLine,
+) (*point.OrderRefund, error) {
+	if refundPaymentAmount < 0 || (refundPointAmount != nil && *refundPointAmount < 0) {
+		return nil, point.ErrInvalidRefundAmount
+	}
+	if refundPaymentAmount == 0 && (refundPointAmount == nil || *refundPointAmount == 0) {
+		return nil, point.ErrInvalidRefundAmount
+	}
+
+	transactions, err := uc.repo.GetTransactionsByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. 누적 환불 내역 조회 (최초 부분 환불이면 원 주문 기준으로 생성)
+	refund, err := uc.repo.GetOrderRefund(ctx, orderID)
+	if err == point.ErrOrderRefundNotFound {
+		refund, err = uc.createOrderRefund(ctx, userPoint.UserID, orderID, paymentAmount, transactions)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if refund.UserID != userPoint.UserID {
+		return nil, point.ErrOrderRefundNotFound
+	}
+	if refund.PaymentAmount != paymentAmount {
+		return nil, point.ErrRefundPaymentMismatch
+	}
+
+	// 2. 환불 한도 확인
+	if refundPaymentAmount > refund.RemainingPaymentAmount() {
+		return nil, point.ErrRefundExceedsPayment
+	}
+	remainingPayment := refund.RemainingPaymentAmount() - refundPaymentAmount
+
+	// 3. 복구할 사용 포인트 계산 (마지막 환불은 남은 포인트 전액)
+	var restoreAmount int64
+	switch {
+	case refundPointAmount != nil:
+		restoreAmount = *refundPointAmount
+	case remainingPayment == 0:
+		restoreAmount = refund.RestorablePointAmount()
+	default:
+		restoreAmount = uc.policy.CalculateRefundPoints(refund.UsedPointAmount, refund.PaymentAmount, refundPaymentAmount)
+	}
+	if restoreAmount > refund.RestorablePointAmount() {
+		return nil, point.ErrRefundExceedsUsedPoints
+	}
+
+	// 4. 회수할 적립 포인트 계산 (환불 라인 적립 포인트, 라인이 없으면 남은 결제 금액 비율만큼만 적립 유지)
+	var clawbackAmount int64
+	if len(lines) > 0 && refund.RetainedEarnedPointAmount() > 0 {
+		clawbackAmount, err = uc.clawbackForLines(ctx, orderID, lines)
+		if err != nil {
+			return nil, err
+		}
+		// 전액 환불이면 캠페인 추가 적립 등 라인에 속하지 않은 적립도 모두 회수
+		if remainingPayment == 0 || clawbackAmount > refund.RetainedEarnedPointAmount() {
+			clawbackAmount = refund.RetainedEarnedPointAmount()
+		}
+	} else {
+		clawbackAmount = uc.policy.CalculateEarnClawback(
+			refund.EarnedPointAmount,
+			refund.RetainedEarnedPointAmount(),
+			refund.PaymentAmount,
+			remainingPayment,
+		)
+	}
+
+	// 5. 사용 포인트 복구
+	if restoreAmount > 0 {
+		if err := uc.restoreUsedPoints(ctx, userPoint, orderID, transactions, restoreAmount, "주문 부분 환불"); err != nil {
+			return nil, err
+		}
+	}
+
+	// 6. 적립 포인트 회수
+	if clawbackAmount > 0 {
+		if err := uc.clawBackPurchaseEarn(ctx, userPoint, orderID, transactions, clawbackAmount); err != nil {
+			return nil, err
+		}
+	}
+
+	// 7. 누적 환불 내역 업데이트
+	refund.Apply(refundPaymentAmount, restoreAmount, clawbackAmount)
+	if err := uc.repo.UpdateOrderRefund(ctx, refund); err != nil {
+		return nil, err
+	}
+
+	return refund, nil
+}
+
+// clawbackForLines 환불 라인의 적립 포인트 회수액 계산 및 라인 환불 수량 반영
+func (uc *RefundPointsUseCase) clawbackForLines(ctx context.Context, orderID int64, lines []point.RefundLine) (int64, error) {
+	orderLines, err := uc.repo.GetOrderLines(ctx, orderID)
+	if err != nil {
+		return 0, err
+	}
+	byLineNo := make(map[int]*point.OrderLine, len(orderLines))
+	for _, line := range orderLines {
+		byLineNo[line.LineNo] = line
+	}
+
+	var clawback int64
+	touched := make(map[int]*point.OrderLine, len(lines))
+	for _, refundLine := range lines {
+		line, ok := byLineNo[refundLine.LineNo]
+		if !ok {
+			return 0, point.ErrOrderLineNotFound
+		}
+		points, err := line.Refund(refundLine.Quantity)
+		if err != nil {
+			return 0, err
+		}
+		clawback += points
+		touched[line.LineNo] = line
+	}
+
+	for _, line := range touched {
+		if err := uc.repo.UpdateOrderLine(ctx, line); err != nil {
+			return 0, err
+		}
+	}
+	return clawback, nil
+}
+
+// createOrderRefund 원 주문의 사용/적립 포인트를 기준으로 부분 환불 내역 생성
+func (uc *RefundPointsUseCase) createOrderRefund(
+	ctx context.Context,
+	userID, orderID, paymentAmount int64,
+	transactions []*point.Transaction,
+) (*point.OrderRefund, error) {
+	if paymentAmount <= 0 {
+		return nil, point.ErrInvalidRefundAmount
+	}
+
+	refund := &point.OrderRefund{
+		OrderID:       orderID,
+		UserID:        userID,
+		PaymentAmount: paymentAmount,
+	}
+	for _, tx := range transactions {
+		// 전체 환불이 이미 처리된 주문
+		if tx.IsRefund() {
+			return nil, point.ErrOrderAlreadyRefunded
+		}
+		if tx.Type == point.TransactionTypeUse && tx.Status == point.TransactionStatusConfirmed {
+			refund.UsedPointAmount += tx.Amount
+		}
+		if tx.IsPurchaseEarn() {
+			refund.EarnedPointAmount += tx.Amount
+		}
+	}
+
+	if err := uc.repo.CreateOrderRefund(ctx, refund); err != nil {
+		return nil, err
+	}
+	return refund, nil
+}
+
+// clawBackPurchaseEarn 주문의 구매 적립에서 amount 만큼 회수
+// 적립 예정 포인트는 취소 후 남은 금액으로 다시 등록하고, 확정된 적립은 lot 잔여 포인트와 잔액에서 회수 (부족분은 부채)
+func (uc *RefundPointsUseCase) clawBackPurchaseEarn(
+	ctx context.Context,
+	userPoint *point.UserPoint,
+	orderID int64,
+	transactions []*point.Transaction,
+	amount int64,
+) error {
+	remaining := amount
+	var confirmedClawback, lotAmount int64
+	for _, tx := range transactions {
+		if remaining <= 0 {
+			break
+		}
+		if !tx.IsPurchaseEarn() {
+			continue
+		}
+
+		if tx.IsPendingEarn() {
+			take := remaining
+			if take > tx.Amount {
+				take = tx.Amount
+			}
+			if err := uc.reducePendingEarn(ctx, userPoint, tx, take); err != nil {
+				return err
+			}
+			remaining -= take
+			continue
+		}
+
+		// 확정된 적립은 거래별 적립 금액까지만 회수 (lot 에 남아 있는 만큼 차감, 이미 사용된 부분은 다른 lot 과 잔액에서 회수)
+		take := remaining
+		if take > tx.Amount {
+			take = tx.Amount
+		}
+		lotAmount += tx.Consume(take)
+		if err := uc.repo.UpdateTransaction(ctx, tx); err != nil {
+			return err
+		}
+		// 캠페인 추가 적립이면 회수한 만큼 예산 반환
+		if err := releaseCampaignBudget(ctx, uc.repo, tx, take); err != nil {
+			return err
+		}
+		confirmedClawback += take
+		remaining -= take
+	}
+
+	if confirmedClawback > 0 {
+		return uc.clawBack(ctx, userPoint, orderID, confirmedClawback, lotAmount, "주문 부분 환불로 인한 적립 회수")
+	}
+	return nil
+}
+
+// reducePendingEarn 적립 예정 거래를 취소하고 남은 금액으로 새 적립 예정 거래 생성
+// 캠페인 추가 적립이면 줄어든 금액만큼 예산 반환
+func (uc *RefundPointsUseCase) reducePendingEarn(ctx context.Context, userPoint *point.UserPoint, tx *point.Transaction, amount int64) error {
+	userPoint.CancelPending(tx.Amount)
+	tx.Status = point.TransactionStatusCancelled
+	if err := uc.repo.UpdateTransaction(ctx, tx); err != nil {
+		return err
+	}
+	if err := recordEvent(ctx, uc.repo, point.EventTypeEarnCancelled, tx); err != nil {
+		return err
+	}
+	if err := releaseCampaignBudget(ctx, uc.repo, tx, amount); err != nil {
+		return err
+	}
+
+	left := tx.Amount - amount
+	if left <= 0 {
+		return nil
+	}
+
+	userPoint.AddPending(left)
+	transaction := &point.Transaction{
+		UserID:       tx.UserID,
+		Type:         point.TransactionTypeEarn,
+		Amount:       left,
+		BalanceAfter: userPoint.AvailableBalance,
+		ReasonType:   tx.ReasonType,
+		ReasonDetail: tx.ReasonDetail,
+		OrderID:      tx.OrderID,
+		CampaignID:   tx.CampaignID,
+		ScheduledAt:  tx.ScheduledAt,
+		Status:       point.TransactionStatusPending,
+		CreatedAt:    time.Now(),
+	}
+	if err := uc.repo.CreateTransaction(ctx, transaction); err != nil {
+		return err
+	}
+	return recordEvent(ctx, uc.repo, point.EventTypeEarned, transaction)
+}
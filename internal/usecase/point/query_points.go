@@ -4,34 +4,45 @@ import (
 	"context"
 	"shopping-mall/internal/domain/point"
 	"shopping-mall/internal/repository/redis"
+	"time"
 )
 
 // QueryPointsUseCase 포인트 조회 유스케이스
 type QueryPointsUseCase struct {
-	repo  point.Repository
-	cache *redis.PointCache
+	repo   point.Repository
+	policy *point.Policy
+	cache  *redis.PointCache
 }
 
 // NewQueryPointsUseCase 포인트 조회 유스케이스 생성
-func NewQueryPointsUseCase(repo point.Repository, cache *redis.PointCache) *QueryPointsUseCase {
+func NewQueryPointsUseCase(repo point.Repository, policy *point.Policy, cache *redis.PointCache) *QueryPointsUseCase {
 	return &QueryPointsUseCase{
-		repo:  repo,
-		cache: cache,
+		repo:   repo,
+		policy: policy,
+		cache:  cache,
 	}
 }
 
-// GetBalance 잔액 조회
+// GetBalance 잔액 조회 (지갑별 사용 가능 포인트 포함)
 func (uc *QueryPointsUseCase) GetBalance(ctx context.Context, userID int64) (*point.UserPoint, error) {
-	// 캐시에서 조회 시도
+	// 캐시에서 조회 시도 (지갑별 잔액이 없는 이전 형식의 캐시는 다시 조회)
 	if uc.cache != nil {
 		cached, err := uc.cache.GetBalance(ctx, userID)
-		if err == nil && cached != nil {
+		if err == nil && cached != nil && cached.WalletBalances != nil {
+			walletBalances := make(map[point.Wallet]int64, len(cached.WalletBalances))
+			for wallet, amount := range cached.WalletBalances {
+				walletBalances[point.Wallet(wallet)] = amount
+			}
 			return &point.UserPoint{
 				UserID:           userID,
 				AvailableBalance: cached.AvailableBalance,
 				PendingBalance:   cached.PendingBalance,
+				HeldBalance:      cached.HeldBalance,
+				DebtBalance:      cached.DebtBalance,
 				TotalEarned:      cached.TotalEarned,
 				TotalUsed:        cached.TotalUsed,
+				UpdatedAt:        cached.UpdatedAt,
+				WalletBalances:   walletBalances,
 			}, nil
 		}
 	}
@@ -41,21 +52,147 @@ func (uc *QueryPointsUseCase) GetBalance(ctx context.Context, userID int64) (*po
 	if err != nil {
 		return nil, err
 	}
+	userPoint.WalletBalances, err = uc.repo.GetWalletBalances(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
 
 	// 캐시에 저장
 	if uc.cache != nil {
-		_ = uc.cache.SetBalance(ctx, userID, &redis.BalanceCache{
-			AvailableBalance: userPoint.AvailableBalance,
-			PendingBalance:   userPoint.PendingBalance,
-			TotalEarned:      userPoint.TotalEarned,
-			TotalUsed:        userPoint.TotalUsed,
-		})
+		_ = uc.cache.SetBalance(ctx, userID, toBalanceCache(userPoint))
 	}
 
 	return userPoint, nil
 }
 
+// Membership 사용자 회원 등급과 등급별 정책이 적용된 유효 정책
+type Membership struct {
+	Tier   point.Tier
+	Policy *point.Policy
+}
+
+// GetMembership 회원 등급 및 유효 정책 조회
+func (uc *QueryPointsUseCase) GetMembership(ctx context.Context, userID int64) (*Membership, error) {
+	tier, policy, err := resolvePolicy(ctx, uc.repo, uc.policy, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &Membership{Tier: tier, Policy: policy}, nil
+}
+
 // GetTransactions 거래 내역 조회
 func (uc *QueryPointsUseCase) GetTransactions(ctx context.Context, userID int64, limit, offset int) ([]*point.Transaction, error) {
 	return uc.repo.GetTransactionsByUser(ctx, userID, limit, offset)
 }
+
+// DebtReport 포인트 부채 현황
+type DebtReport struct {
+	UserCount int64
+	TotalDebt int64
+	Users     []*point.UserPoint
+}
+
+// GetDebtReport 포인트 부채 현황 조회 (부채 큰 순)
+func (uc *QueryPointsUseCase) GetDebtReport(ctx context.Context, limit, offset int) (*DebtReport, error) {
+	userCount, totalDebt, err := uc.repo.GetDebtSummary(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := uc.repo.GetUsersWithDebt(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DebtReport{
+		UserCount: userCount,
+		TotalDebt: totalDebt,
+		Users:     users,
+	}, nil
+}
+
+// CheckoutQuote 결제 화면 포인트 견적 (사용 가능 포인트 / 적립 예정 포인트)
+type CheckoutQuote struct {
+	Tier             point.Tier
+	AvailableBalance int64
+	OrderAmount      int64
+	MaxUsableAmount  int64          // 사용 정책을 모두 만족하는 최대 사용 포인트
+	UseLimit         point.UseLimit // 최대 사용 포인트를 제한하는 사유
+	UseAmount        int64          // 견적에 사용한 사용 포인트
+	UseError         error          // 사용 포인트가 사용 정책을 위반하면 해당 오류 (nil 이면 사용 가능)
+	EarnableAmount   int64          // 적립 대상 결제 금액
+	EarnPoints       int64          // 기본 적립 예정 포인트 (캠페인 추가 적립 제외)
+	LineEarns        []point.LineEarn
+	CampaignBonuses  []CampaignBonus // 캠페인별 추가 적립 예정 포인트
+	CampaignPoints   int64           // 캠페인 추가 적립 예정 포인트 합계
+	TotalEarnPoints  int64           // 기본 적립과 캠페인 추가 적립을 합한 적립 예정 포인트
+}
+
+// Quote 결제 전 포인트 견적 조회 (조회 전용, 잔액을 변경하지 않음)
+// order.PaymentAmount 는 포인트 사용 전 주문 금액, useAmount 는 사용하려는 포인트
+func (uc *QueryPointsUseCase) Quote(ctx context.Context, userID int64, order *point.PurchaseOrder, useAmount int64) (*CheckoutQuote, error) {
+	if order.PaymentAmount <= 0 || useAmount < 0 {
+		return nil, point.ErrInvalidQuoteAmount
+	}
+	if err := point.ValidateLineItems(order.Items); err != nil {
+		return nil, err
+	}
+
+	// 1. 사용 가능 포인트 조회 (포인트 정보가 없으면 0)
+	var availableBalance int64
+	userPoint, err := uc.GetBalance(ctx, userID)
+	if err == nil {
+		availableBalance = userPoint.AvailableBalance
+	} else if err != point.ErrPointNotFound {
+		return nil, err
+	}
+
+	// 2. 회원 등급별 유효 정책으로 최대 사용 포인트 계산
+	tier, policy, err := resolvePolicy(ctx, uc.repo, uc.policy, userID)
+	if err != nil {
+		return nil, err
+	}
+	maxUsable, limit := policy.MaxUsableAmount(order.PaymentAmount, availableBalance)
+
+	quote := &CheckoutQuote{
+		Tier:             tier,
+		AvailableBalance: availableBalance,
+		OrderAmount:      order.PaymentAmount,
+		MaxUsableAmount:  maxUsable,
+		UseLimit:         limit,
+		UseAmount:        useAmount,
+	}
+
+	// 3. 사용하려는 포인트 검증
+	if useAmount > 0 {
+		quote.UseError = policy.ValidateUse(useAmount, order.PaymentAmount, availableBalance)
+	}
+
+	// 4. 사용 포인트를 반영한 적립 예정 포인트 계산
+	quote.EarnableAmount = policy.EarnableAmount(order.PaymentAmount, useAmount)
+	quote.LineEarns, quote.EarnPoints, err = calculatePurchaseEarn(ctx, uc.repo, policy, order, useAmount)
+	if err != nil {
+		return nil, err
+	}
+	quote.TotalEarnPoints = quote.EarnPoints
+
+	// 5. 진행 중인 캠페인 추가 적립 예정 포인트 계산 (기본 적립이 없으면 적립하지 않으며, 예산은 차감하지 않음)
+	if quote.EarnPoints <= 0 {
+		return quote, nil
+	}
+	quote.CampaignBonuses, quote.CampaignPoints, err = quoteCampaigns(ctx, uc.repo, &point.EarnContext{
+		UserID:        userID,
+		Tier:          tier,
+		ReasonType:    point.ReasonTypePurchase,
+		Categories:    order.EarnCategories(),
+		PaymentAmount: quote.EarnableAmount,
+		BaseAmount:    quote.EarnPoints,
+		At:            time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	quote.TotalEarnPoints += quote.CampaignPoints
+
+	return quote, nil
+}
@@ -0,0 +1,190 @@
+package point
+
+import (
+	"context"
+	"shopping-mall/internal/domain/point"
+	"shopping-mall/internal/repository/redis"
+	"time"
+)
+
+// ReconcilePointsUseCase 잔액-거래 내역 정합성 검증 유스케이스
+type ReconcilePointsUseCase struct {
+	repo   point.Repository
+	tm     point.TransactionManager
+	policy *point.Policy
+	cache  *redis.PointCache
+}
+
+// NewReconcilePointsUseCase 정합성 검증 유스케이스 생성
+func NewReconcilePointsUseCase(repo point.Repository, tm point.TransactionManager, policy *point.Policy, cache *redis.PointCache) *ReconcilePointsUseCase {
+	return &ReconcilePointsUseCase{
+		repo:   repo,
+		tm:     tm,
+		policy: policy,
+		cache:  cache,
+	}
+}
+
+// ReconcileResult 전체 정합성 검증 결과
+type ReconcileResult struct {
+	Checked    int // 검증한 사용자 수
+	Mismatched int // 불일치 사용자 수
+	Corrected  int // 자동 보정한 사용자 수
+	Failed     int // 검증에 실패한 사용자 수
+
+	Failures []ReconcileFailure // 검증에 실패한 사용자와 오류
+}
+
+// ReconcileFailure 정합성 검증에 실패한 사용자와 오류
+type ReconcileFailure struct {
+	UserID int64
+	Err    error
+}
+
+// ReconcileUser 사용자 잔액을 거래 내역으로 재계산하여 검증
+// 불일치하면 리포트를 기록하고, autoCorrect 이면 ADMIN 보정 거래로 거래 내역을 잔액에 맞춤
+func (uc *ReconcilePointsUseCase) ReconcileUser(ctx context.Context, userID int64, autoCorrect bool) (*point.Reconciliation, error) {
+	var reconciliation *point.Reconciliation
+	err := uc.tm.WithTransaction(ctx, func(txCtx context.Context) error {
+		// 1. 포인트 잔액 조회 (FOR UPDATE 락, 검증 중 잔액 변경 방지)
+		userPoint, err := uc.repo.GetUserPointForUpdate(txCtx, userID)
+		if err != nil {
+			return err
+		}
+
+		// 2. 거래 내역으로 잔액 재계산
+		ledger, err := uc.repo.GetLedgerSummary(txCtx, userID)
+		if err != nil {
+			return err
+		}
+
+		// 3. 비교
+		reconciliation = point.NewReconciliation(userPoint, ledger)
+		if !reconciliation.HasDiscrepancy() {
+			return nil
+		}
+
+		// 4. 자동 보정
+		if autoCorrect {
+			if err := uc.correct(txCtx, userPoint, reconciliation); err != nil {
+				return err
+			}
+			reconciliation.Corrected = true
+		}
+
+		// 5. 불일치 리포트 기록
+		return uc.repo.CreateReconciliation(txCtx, reconciliation)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reconciliation, nil
+}
+
+// ReconcileAll 전체 사용자를 batchSize 단위로 검증 (사용자마다 별도 트랜잭션)
+// 검증에 실패한 사용자는 건너뛰고 사용자 ID 와 오류를 결과에 기록
+func (uc *ReconcilePointsUseCase) ReconcileAll(ctx context.Context, batchSize int, autoCorrect bool) (*ReconcileResult, error) {
+	result := &ReconcileResult{}
+	var afterUserID int64
+	for {
+		userIDs, err := uc.repo.GetUserIDsAfter(ctx, afterUserID, batchSize)
+		if err != nil {
+			return result, err
+		}
+		if len(userIDs) == 0 {
+			return result, nil
+		}
+
+		for _, userID := range userIDs {
+			if err := ctx.Err(); err != nil {
+				return result, err
+			}
+
+			result.Checked++
+			reconciliation, err := uc.ReconcileUser(ctx, userID, autoCorrect)
+			if err != nil {
+				result.Failed++
+				result.Failures = append(result.Failures, ReconcileFailure{UserID: userID, Err: err})
+				continue
+			}
+			if reconciliation.HasDiscrepancy() {
+				result.Mismatched++
+			}
+			if reconciliation.Corrected {
+				result.Corrected++
+			}
+		}
+
+		afterUserID = userIDs[len(userIDs)-1]
+	}
+}
+
+// correct 잔액 테이블을 기준으로 거래 내역 보정
+// 순잔액 차이는 ADMIN 보정 거래(적립/회수)로 거래 내역에 기록하고,
+// 적립 예정/예약/누적 집계는 보정 후 거래 내역 기준으로 다시 맞춤
+func (uc *ReconcilePointsUseCase) correct(ctx context.Context, userPoint *point.UserPoint, reconciliation *point.Reconciliation) error {
+	now := time.Now()
+	gap := reconciliation.BalanceGap()
+
+	// 1. 순잔액 보정 거래 생성
+	if gap > 0 {
+		// 거래 내역보다 잔액이 많음 → 보정 적립 (사용 가능한 lot 으로 기록)
+		expiresAt := uc.policy.CalculateExpiryDate(now)
+		transaction := &point.Transaction{
+			UserID:          userPoint.UserID,
+			Type:            point.TransactionTypeEarn,
+			Amount:          gap,
+			RemainingAmount: gap,
+			BalanceAfter:    userPoint.AvailableBalance,
+			ReasonType:      point.ReasonTypeAdmin,
+			ReasonDetail:    "정합성 검증 보정 적립",
+			EarnedAt:        &now,
+			ExpiresAt:       &expiresAt,
+			Status:          point.TransactionStatusConfirmed,
+			CreatedAt:       now,
+		}
+		if err := uc.repo.CreateTransaction(ctx, transaction); err != nil {
+			return err
+		}
+		if err := recordEvent(ctx, uc.repo, point.EventTypeAdjusted, transaction); err != nil {
+			return err
+		}
+	}
+	if gap < 0 {
+		// 거래 내역보다 잔액이 적음 → 보정 회수 (남아 있는 lot 에서 차감)
+		if _, err := consumeLots(ctx, uc.repo, userPoint.UserID, -gap, uc.policy.WalletUseOrder); err != nil {
+			return err
+		}
+		transaction := &point.Transaction{
+			UserID:       userPoint.UserID,
+			Type:         point.TransactionTypeCancel,
+			Amount:       -gap,
+			BalanceAfter: userPoint.AvailableBalance,
+			ReasonType:   point.ReasonTypeAdmin,
+			ReasonDetail: "정합성 검증 보정 회수",
+			Status:       point.TransactionStatusConfirmed,
+			CreatedAt:    now,
+		}
+		if err := uc.repo.CreateTransaction(ctx, transaction); err != nil {
+			return err
+		}
+		if err := recordEvent(ctx, uc.repo, point.EventTypeAdjusted, transaction); err != nil {
+			return err
+		}
+	}
+
+	// 2. 집계 항목은 보정 후 거래 내역 기준으로 갱신
+	ledger, err := uc.repo.GetLedgerSummary(ctx, userPoint.UserID)
+	if err != nil {
+		return err
+	}
+	userPoint.PendingBalance = ledger.PendingBalance
+	userPoint.HeldBalance = ledger.HeldBalance
+	userPoint.TotalEarned = ledger.TotalEarned
+	userPoint.TotalUsed = ledger.TotalUsed
+	userPoint.UpdatedAt = now
+
+	// 3. 잔액 업데이트
+	invalidateBalance(ctx, uc.tm, uc.cache, userPoint.UserID)
+	return uc.repo.UpdateUserPoint(ctx, userPoint)
+}
@@ -2,40 +2,71 @@ package point
 
 import (
 	"context"
+	"fmt"
 	"shopping-mall/internal/domain/point"
+	"shopping-mall/internal/repository/redis"
 	"time"
 )
 
 // RefundPointsUseCase 포인트 환불 유스케이스
 type RefundPointsUseCase struct {
-	repo point.Repository
-	tm   point.TransactionManager
+	repo   point.Repository
+	tm     point.TransactionManager
+	policy *point.Policy
+	cache  *redis.PointCache
 }
 
 // NewRefundPointsUseCase 포인트 환불 유스케이스 생성
-func NewRefundPointsUseCase(repo point.Repository, tm point.TransactionManager) *RefundPointsUseCase {
+func NewRefundPointsUseCase(repo point.Repository, tm point.TransactionManager, policy *point.Policy, cache *redis.PointCache) *RefundPointsUseCase {
 	return &RefundPointsUseCase{
-		repo: repo,
-		tm:   tm,
+		repo:   repo,
+		tm:     tm,
+		policy: policy,
+		cache:  cache,
 	}
 }
 
 // RefundPoints 포인트 환불
+// 부분 환불 내역이 있는 주문은 남은 결제 금액 전액을 부분 환불로 처리
 func (uc *RefundPointsUseCase) RefundPoints(ctx context.Context, userID int64, orderID int64) error {
 	return uc.tm.WithTransaction(ctx, func(txCtx context.Context) error {
-		// 1. 주문 관련 거래 내역 조회
-		transactions, err := uc.repo.GetTransactionsByOrderID(txCtx, orderID)
+		// 1. 포인트 잔액 조회
+		userPoint, err := uc.repo.GetUserPointForUpdate(txCtx, userID)
 		if err != nil {
 			return err
 		}
 
-		// 2. 포인트 잔액 조회
-		userPoint, err := uc.repo.GetUserPoint(txCtx, userID)
+		// 2. 부분 환불된 주문이면 남은 금액 전액 환불
+		refund, err := uc.repo.GetOrderRefund(txCtx, orderID)
+		if err == nil {
+			if refund.IsFullyRefunded() && refund.RestorablePointAmount() == 0 {
+				return point.ErrOrderAlreadyRefunded
+			}
+			restorable := refund.RestorablePointAmount()
+			if _, err := uc.refundPartial(txCtx, userPoint, orderID, refund.PaymentAmount, refund.RemainingPaymentAmount(), &restorable, nil); err != nil {
+				return err
+			}
+			invalidateBalance(txCtx, uc.tm, uc.cache, userPoint.UserID)
+			return uc.repo.UpdateUserPoint(txCtx, userPoint)
+		}
+		if err != point.ErrOrderRefundNotFound {
+			return err
+		}
+
+		// 3. 주문 관련 거래 내역 조회
+		transactions, err := uc.repo.GetTransactionsByOrderID(txCtx, orderID)
 		if err != nil {
 			return err
 		}
 
-		// 3. 사용했던 포인트 복구
+		// 4. 이미 환불 처리된 주문인지 확인
+		for _, tx := range transactions {
+			if tx.IsRefund() {
+				return point.ErrOrderAlreadyRefunded
+			}
+		}
+
+		// 5. 사용했던 포인트 복구 (차감했던 적립 lot 으로 되돌림)
 		var usedAmount int64
 		for _, tx := range transactions {
 			if tx.Type == point.TransactionTypeUse && tx.Status == point.TransactionStatusConfirmed {
@@ -44,68 +75,155 @@ func (uc *RefundPointsUseCase) RefundPoints(ctx context.Context, userID int64, o
 		}
 
 		if usedAmount > 0 {
-			// 포인트 환불
-			userPoint.Refund(usedAmount)
-
-			// 환불 거래 내역 생성
-			transaction := &point.Transaction{
-				UserID:       userID,
-				Type:         point.TransactionTypeEarn,
-				Amount:       usedAmount,
-				BalanceAfter: userPoint.AvailableBalance,
-				ReasonType:   point.ReasonTypeRefund,
-				ReasonDetail: "주문 환불",
-				OrderID:      &orderID,
-				Status:       point.TransactionStatusConfirmed,
-				CreatedAt:    time.Now(),
+			if err := uc.restoreUsedPoints(txCtx, userPoint, orderID, transactions, usedAmount, "주문 환불"); err != nil {
+				return err
 			}
+		}
 
-			if err := uc.repo.CreateTransaction(txCtx, transaction); err != nil {
-				return err
+		// 6. 아직 확정되지 않은 적립 예정 포인트 취소 (캠페인 추가 적립은 예산 반환)
+		for _, tx := range transactions {
+			if tx.IsPendingEarn() {
+				userPoint.CancelPending(tx.Amount)
+				tx.Status = point.TransactionStatusCancelled
+				if err := uc.repo.UpdateTransaction(txCtx, tx); err != nil {
+					return err
+				}
+				if err := recordEvent(txCtx, uc.repo, point.EventTypeEarnCancelled, tx); err != nil {
+					return err
+				}
+				if err := releaseCampaignBudget(txCtx, uc.repo, tx, tx.Amount); err != nil {
+					return err
+				}
 			}
 		}
 
-		// 4. 이미 적립된 포인트 회수
-		var earnedAmount int64
+		// 7. 이미 적립된 포인트 회수 (캠페인 추가 적립은 예산 반환)
+		var earnedAmount, lotAmount int64
 		for _, tx := range transactions {
 			if tx.Type == point.TransactionTypeEarn && tx.Status == point.TransactionStatusConfirmed {
 				earnedAmount += tx.Amount
+				lotAmount += tx.RemainingAmount
+
+				// 적립 거래 내역 취소 처리 (잔여 포인트도 함께 소멸)
+				tx.Status = point.TransactionStatusCancelled
+				tx.RemainingAmount = 0
+				if err := uc.repo.UpdateTransaction(txCtx, tx); err != nil {
+					return err
+				}
+				if err := releaseCampaignBudget(txCtx, uc.repo, tx, tx.Amount); err != nil {
+					return err
+				}
 			}
 		}
 
 		if earnedAmount > 0 {
-			// 포인트 회수
-			userPoint.Expire(earnedAmount)
-
-			// 취소 거래 내역 생성
-			transaction := &point.Transaction{
-				UserID:       userID,
-				Type:         point.TransactionTypeCancel,
-				Amount:       earnedAmount,
-				BalanceAfter: userPoint.AvailableBalance,
-				ReasonType:   point.ReasonTypeRefund,
-				ReasonDetail: "주문 환불로 인한 적립 취소",
-				OrderID:      &orderID,
-				Status:       point.TransactionStatusCancelled,
-				CreatedAt:    time.Now(),
-			}
-
-			if err := uc.repo.CreateTransaction(txCtx, transaction); err != nil {
+			// 포인트 회수 (잔액이 부족하면 부채로 기록)
+			if err := uc.clawBack(txCtx, userPoint, orderID, earnedAmount, lotAmount, "주문 환불로 인한 적립 취소"); err != nil {
 				return err
 			}
-
-			// 적립 거래 내역 취소 처리
-			for _, tx := range transactions {
-				if tx.Type == point.TransactionTypeEarn && tx.Status == point.TransactionStatusConfirmed {
-					tx.Status = point.TransactionStatusCancelled
-					if err := uc.repo.UpdateTransaction(txCtx, tx); err != nil {
-						return err
-					}
-				}
-			}
 		}
 
-		// 5. 잔액 업데이트
+		// 8. 잔액 업데이트
+		invalidateBalance(txCtx, uc.tm, uc.cache, userPoint.UserID)
 		return uc.repo.UpdateUserPoint(txCtx, userPoint)
 	})
 }
+
+// restoreUsedPoints 주문의 사용 거래가 차감했던 적립 lot 으로 amount 만큼 복구하고 환불 거래 내역 생성
+// 원래 lot 으로 복구할 수 없는 포인트(만료/취소된 lot, 차감 내역 없음)는 환불 거래를 새 lot 으로 적립
+func (uc *RefundPointsUseCase) restoreUsedPoints(
+	ctx context.Context,
+	userPoint *point.UserPoint,
+	orderID int64,
+	transactions []*point.Transaction,
+	amount int64,
+	reasonDetail string,
+) error {
+	var restoredToLots int64
+	remaining := amount
+	for _, tx := range transactions {
+		if remaining <= 0 {
+			break
+		}
+		if tx.Type != point.TransactionTypeUse || tx.Status != point.TransactionStatusConfirmed {
+			continue
+		}
+
+		restored, processed, err := restoreAllocations(ctx, uc.repo, tx, remaining)
+		if err != nil {
+			return err
+		}
+		restoredToLots += restored
+		remaining -= processed
+	}
+
+	// 포인트 환불
+	userPoint.Refund(amount)
+
+	// 환불 거래 내역 생성
+	now := time.Now()
+	unrestoredAmount := amount - restoredToLots
+	transaction := &point.Transaction{
+		UserID:          userPoint.UserID,
+		Type:            point.TransactionTypeEarn,
+		Amount:          amount,
+		RemainingAmount: unrestoredAmount,
+		BalanceAfter:    userPoint.AvailableBalance,
+		ReasonType:      point.ReasonTypeRefund,
+		ReasonDetail:    reasonDetail,
+		OrderID:         &orderID,
+		Status:          point.TransactionStatusConfirmed,
+		CreatedAt:       now,
+	}
+	if unrestoredAmount > 0 {
+		expiresAt := uc.policy.CalculateExpiryDate(now)
+		transaction.EarnedAt = &now
+		transaction.ExpiresAt = &expiresAt
+	}
+
+	if err := uc.repo.CreateTransaction(ctx, transaction); err != nil {
+		return err
+	}
+	return recordEvent(ctx, uc.repo, point.EventTypeRefunded, transaction)
+}
+
+// clawBack 적립 포인트 회수 및 취소 거래 내역 생성
+// 주문 적립 lot 에서 이미 차감한 lotAmount 를 제외한 나머지는 다른 적립 lot 에서 차감하고,
+// 사용 가능 포인트가 부족하면 부족분을 부채로 기록
+func (uc *RefundPointsUseCase) clawBack(
+	ctx context.Context,
+	userPoint *point.UserPoint,
+	orderID int64,
+	amount, lotAmount int64,
+	reasonDetail string,
+) error {
+	// 1. 이미 사용된 적립분은 다른 적립 lot 에서 차감
+	if rest := amount - lotAmount; rest > 0 {
+		if _, err := consumeLots(ctx, uc.repo, userPoint.UserID, rest, uc.policy.WalletUseOrder); err != nil {
+			return err
+		}
+	}
+
+	// 2. 포인트 회수 (부족분은 부채)
+	if debt := userPoint.ClawBack(amount); debt > 0 {
+		reasonDetail = fmt.Sprintf("%s (부채 %d 포인트 발생)", reasonDetail, debt)
+	}
+
+	// 3. 취소 거래 내역 생성
+	transaction := &point.Transaction{
+		UserID:       userPoint.UserID,
+		Type:         point.TransactionTypeCancel,
+		Amount:       amount,
+		BalanceAfter: userPoint.AvailableBalance,
+		ReasonType:   point.ReasonTypeRefund,
+		ReasonDetail: reasonDetail,
+		OrderID:      &orderID,
+		Status:       point.TransactionStatusCancelled,
+		CreatedAt:    time.Now(),
+	}
+
+	if err := uc.repo.CreateTransaction(ctx, transaction); err != nil {
+		return err
+	}
+	return recordEvent(ctx, uc.repo, point.EventTypeEarnCancelled, transaction)
+}
@@ -0,0 +1,325 @@
+package point
+
+import (
+	"context"
+	"fmt"
+	"shopping-mall/internal/domain/point"
+	"shopping-mall/internal/repository/redis"
+	"time"
+)
+
+// ReservePointsUseCase 포인트 예약(hold / capture / release) 유스케이스
+type ReservePointsUseCase struct {
+	repo   point.Repository
+	tm     point.TransactionManager
+	policy *point.Policy
+	cache  *redis.PointCache
+}
+
+// NewReservePointsUseCase 포인트 예약 유스케이스 생성
+func NewReservePointsUseCase(repo point.Repository, tm point.TransactionManager, policy *point.Policy, cache *redis.PointCache) *ReservePointsUseCase {
+	return &ReservePointsUseCase{
+		repo:   repo,
+		tm:     tm,
+		policy: policy,
+		cache:  cache,
+	}
+}
+
+// Reserve 결제 전 포인트 예약 (사용 가능 포인트 → 예약 포인트)
+func (uc *ReservePointsUseCase) Reserve(ctx context.Context, userID int64, useAmount, orderAmount int64, orderID int64) (*point.Reservation, error) {
+	var reservation *point.Reservation
+	err := uc.tm.WithTransaction(ctx, func(txCtx context.Context) error {
+		// 1. 포인트 잔액 조회 (FOR UPDATE 락)
+		userPoint, err := uc.repo.GetUserPointForUpdate(txCtx, userID)
+		if err != nil {
+			return err
+		}
+
+		// 2. 같은 주문으로 이미 사용/예약했는지 확인
+		orderTransactions, err := uc.repo.GetTransactionsByOrderID(txCtx, orderID)
+		if err != nil {
+			return err
+		}
+		for _, tx := range orderTransactions {
+			if tx.IsPurchaseUse() {
+				return point.ErrOrderAlreadyUsed
+			}
+		}
+
+		// 3. 사용 유효성 검증 (회원 등급별 정책 적용)
+		_, policy, err := resolvePolicy(txCtx, uc.repo, uc.policy, userID)
+		if err != nil {
+			return err
+		}
+		if err := policy.ValidateUse(useAmount, orderAmount, userPoint.AvailableBalance); err != nil {
+			return err
+		}
+
+		// 4. 지갑 사용 순서대로 적립 lot 에서 차감할 금액 계산 (같은 지갑은 FIFO)
+		plan, err := planLotConsumption(txCtx, uc.repo, userID, useAmount, policy.WalletUseOrder)
+		if err != nil {
+			return err
+		}
+
+		// 5. 포인트 예약 (도메인 로직)
+		if err := userPoint.Hold(useAmount); err != nil {
+			return err
+		}
+
+		// 6. 예약 사용 거래 내역 생성 (capture 시 확정)
+		now := time.Now()
+		transaction := &point.Transaction{
+			UserID:       userID,
+			Type:         point.TransactionTypeUse,
+			Amount:       useAmount,
+			BalanceAfter: userPoint.AvailableBalance,
+			ReasonType:   point.ReasonTypePurchase,
+			ReasonDetail: "주문 결제 포인트 예약",
+			OrderID:      &orderID,
+			Status:       point.TransactionStatusPending,
+			CreatedAt:    now,
+		}
+
+		if err := uc.repo.CreateTransaction(txCtx, transaction); err != nil {
+			return err
+		}
+
+		// 7. 적립 lot 잔여 포인트 차감 및 차감 내역 기록
+		if err := plan.save(txCtx, uc.repo, transaction.ID); err != nil {
+			return err
+		}
+
+		// 8. 예약 생성
+		reservation = &point.Reservation{
+			UserID:           userID,
+			OrderID:          orderID,
+			UseTransactionID: transaction.ID,
+			Amount:           useAmount,
+			OrderAmount:      orderAmount,
+			Status:           point.ReservationStatusHeld,
+			ExpiresAt:        uc.policy.CalculateHoldExpiry(now),
+		}
+		if err := uc.repo.CreateReservation(txCtx, reservation); err != nil {
+			return err
+		}
+
+		// 9. 잔액 업데이트
+		invalidateBalance(txCtx, uc.tm, uc.cache, userPoint.UserID)
+		return uc.repo.UpdateUserPoint(txCtx, userPoint)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reservation, nil
+}
+
+// Capture 결제 성공 시 예약 포인트 사용 확정
+func (uc *ReservePointsUseCase) Capture(ctx context.Context, userID int64, orderID int64) error {
+	return uc.tm.WithTransaction(ctx, func(txCtx context.Context) error {
+		// 1. 포인트 잔액 조회 (FOR UPDATE 락)
+		userPoint, err := uc.repo.GetUserPointForUpdate(txCtx, userID)
+		if err != nil {
+			return err
+		}
+
+		// 2. 진행 중인 예약 조회
+		reservation, err := uc.getHeldReservation(txCtx, userID, orderID)
+		if err != nil {
+			return err
+		}
+		if reservation.IsExpired(time.Now()) {
+			return point.ErrReservationExpired
+		}
+
+		// 3. 예약 사용 거래 확정
+		useTx, err := uc.repo.GetTransactionByID(txCtx, reservation.UseTransactionID)
+		if err != nil {
+			return err
+		}
+		useTx.Status = point.TransactionStatusConfirmed
+		if err := uc.repo.UpdateTransaction(txCtx, useTx); err != nil {
+			return err
+		}
+		if err := recordEvent(txCtx, uc.repo, point.EventTypeUsed, useTx); err != nil {
+			return err
+		}
+
+		// 4. 예약 포인트 사용 확정 (도메인 로직)
+		userPoint.CaptureHold(reservation.Amount)
+
+		reservation.Status = point.ReservationStatusCaptured
+		if err := uc.repo.UpdateReservation(txCtx, reservation); err != nil {
+			return err
+		}
+
+		// 5. 잔액 업데이트
+		invalidateBalance(txCtx, uc.tm, uc.cache, userPoint.UserID)
+		return uc.repo.UpdateUserPoint(txCtx, userPoint)
+	})
+}
+
+// Release 결제 실패 시 예약 포인트 해제
+func (uc *ReservePointsUseCase) Release(ctx context.Context, userID int64, orderID int64) error {
+	return uc.tm.WithTransaction(ctx, func(txCtx context.Context) error {
+		// 1. 포인트 잔액 조회 (FOR UPDATE 락)
+		userPoint, err := uc.repo.GetUserPointForUpdate(txCtx, userID)
+		if err != nil {
+			return err
+		}
+
+		// 2. 진행 중인 예약 조회
+		reservation, err := uc.getHeldReservation(txCtx, userID, orderID)
+		if err != nil {
+			return err
+		}
+
+		// 3. 예약 해제
+		if err := uc.release(txCtx, userPoint, reservation, point.ReservationStatusReleased); err != nil {
+			return err
+		}
+
+		// 4. 잔액 업데이트
+		invalidateBalance(txCtx, uc.tm, uc.cache, userPoint.UserID)
+		return uc.repo.UpdateUserPoint(txCtx, userPoint)
+	})
+}
+
+// ReleaseResult 만료 예약 해제 배치 결과
+type ReleaseResult struct {
+	Checked  int // 만료 대상 예약 수
+	Released int // 해제한 예약 수
+	Failed   int // 해제에 실패한 예약 수
+}
+
+// ReleaseExpired 유효시간이 지난 예약 자동 해제
+// 예약마다 별도 트랜잭션으로 처리하여 여러 사용자의 락을 한 번에 잡지 않고, 실패한 예약은 건너뛴 뒤 첫 오류를 반환
+func (uc *ReservePointsUseCase) ReleaseExpired(ctx context.Context, before time.Time, limit int) (*ReleaseResult, error) {
+	result := &ReleaseResult{}
+
+	// 1. 만료 대상 조회 (락 없음)
+	reservations, err := uc.repo.GetExpiredReservations(ctx, before, limit)
+	if err != nil {
+		return result, err
+	}
+
+	// 2. 예약별 해제 처리
+	var firstErr error
+	for _, expired := range reservations {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		result.Checked++
+		released, err := uc.releaseExpired(ctx, expired)
+		if err != nil {
+			result.Failed++
+			if firstErr == nil {
+				firstErr = fmt.Errorf("failed to release expired reservation of order %d: %w", expired.OrderID, err)
+			}
+			continue
+		}
+		if released {
+			result.Released++
+		}
+	}
+
+	return result, firstErr
+}
+
+// releaseExpired 만료된 예약 하나를 해제 (이미 확정/해제된 예약이면 false)
+func (uc *ReservePointsUseCase) releaseExpired(ctx context.Context, expired *point.Reservation) (bool, error) {
+	released := false
+	err := uc.tm.WithTransaction(ctx, func(txCtx context.Context) error {
+		// 1. 포인트 잔액 조회 (FOR UPDATE 락)
+		userPoint, err := uc.repo.GetUserPointForUpdate(txCtx, expired.UserID)
+		if err == point.ErrPointNotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// 2. 사용자 락 획득 후 아직 예약 중인지 다시 확인
+		reservation, err := uc.getHeldReservation(txCtx, expired.UserID, expired.OrderID)
+		if err == point.ErrReservationNotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := uc.release(txCtx, userPoint, reservation, point.ReservationStatusExpired); err != nil {
+			return err
+		}
+
+		// 3. 잔액 업데이트
+		invalidateBalance(txCtx, uc.tm, uc.cache, userPoint.UserID)
+		if err := uc.repo.UpdateUserPoint(txCtx, userPoint); err != nil {
+			return err
+		}
+		released = true
+		return nil
+	})
+	return released, err
+}
+
+// getHeldReservation 사용자의 진행 중인 주문 예약 조회
+func (uc *ReservePointsUseCase) getHeldReservation(ctx context.Context, userID, orderID int64) (*point.Reservation, error) {
+	reservation, err := uc.repo.GetHeldReservationByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if reservation.UserID != userID {
+		return nil, point.ErrReservationNotFound
+	}
+	return reservation, nil
+}
+
+// release 예약 사용 거래 취소, 적립 lot 복구, 예약 포인트 해제
+// 예약 중 만료된 lot 의 포인트는 복구하지 않고 만료 처리
+func (uc *ReservePointsUseCase) release(ctx context.Context, userPoint *point.UserPoint, reservation *point.Reservation, status point.ReservationStatus) error {
+	useTx, err := uc.repo.GetTransactionByID(ctx, reservation.UseTransactionID)
+	if err != nil {
+		return err
+	}
+	useTx.Status = point.TransactionStatusCancelled
+	if err := uc.repo.UpdateTransaction(ctx, useTx); err != nil {
+		return err
+	}
+
+	restored, _, err := restoreAllocations(ctx, uc.repo, useTx, reservation.Amount)
+	if err != nil {
+		return err
+	}
+
+	userPoint.ReleaseHold(reservation.Amount)
+
+	if unrestored := reservation.Amount - restored; unrestored > 0 {
+		// 잔액이 부족하면 잔액과 lot 이 어긋난 것이므로 롤백
+		if err := userPoint.Expire(unrestored); err != nil {
+			return err
+		}
+
+		transaction := &point.Transaction{
+			UserID:       userPoint.UserID,
+			Type:         point.TransactionTypeExpire,
+			Amount:       unrestored,
+			BalanceAfter: userPoint.AvailableBalance,
+			ReasonType:   point.ReasonTypeAdmin,
+			ReasonDetail: "예약 해제 시 만료된 포인트 소멸",
+			OrderID:      &reservation.OrderID,
+			Status:       point.TransactionStatusConfirmed,
+			CreatedAt:    time.Now(),
+		}
+		if err := uc.repo.CreateTransaction(ctx, transaction); err != nil {
+			return err
+		}
+		if err := recordEvent(ctx, uc.repo, point.EventTypeExpired, transaction); err != nil {
+			return err
+		}
+	}
+
+	reservation.Status = status
+	return uc.repo.UpdateReservation(ctx, reservation)
+}
@@ -0,0 +1,21 @@
+package point
+
+import (
+	"context"
+	"shopping-mall/internal/domain/point"
+)
+
+// resolvePolicy 사용자 회원 등급의 재정의를 적용한 유효 정책 조회
+func resolvePolicy(ctx context.Context, repo point.Repository, policy *point.Policy, userID int64) (point.Tier, *point.Policy, error) {
+	userTier, err := repo.GetUserTier(ctx, userID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	tierPolicy, err := repo.GetTierPolicy(ctx, userTier.Tier)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return userTier.Tier, policy.ForTier(tierPolicy), nil
+}
@@ -3,6 +3,7 @@ package point
 import (
 	"context"
 	"shopping-mall/internal/domain/point"
+	"shopping-mall/internal/repository/redis"
 	"time"
 )
 
@@ -11,14 +12,16 @@ type UsePointsUseCase struct {
 	repo   point.Repository
 	tm     point.TransactionManager
 	policy *point.Policy
+	cache  *redis.PointCache
 }
 
 // NewUsePointsUseCase 포인트 사용 유스케이스 생성
-func NewUsePointsUseCase(repo point.Repository, tm point.TransactionManager, policy *point.Policy) *UsePointsUseCase {
+func NewUsePointsUseCase(repo point.Repository, tm point.TransactionManager, policy *point.Policy, cache *redis.PointCache) *UsePointsUseCase {
 	return &UsePointsUseCase{
 		repo:   repo,
 		tm:     tm,
 		policy: policy,
+		cache:  cache,
 	}
 }
 
@@ -26,46 +29,43 @@ func NewUsePointsUseCase(repo point.Repository, tm point.TransactionManager, pol
 func (uc *UsePointsUseCase) UsePoints(ctx context.Context, userID int64, useAmount, orderAmount int64, orderID int64) error {
 	return uc.tm.WithTransaction(ctx, func(txCtx context.Context) error {
 		// 1. 포인트 잔액 조회 (FOR UPDATE 락)
-		userPoint, err := uc.repo.GetUserPoint(txCtx, userID)
+		userPoint, err := uc.repo.GetUserPointForUpdate(txCtx, userID)
 		if err != nil {
 			return err
 		}
 
-		// 2. 사용 유효성 검증
-		if err := uc.policy.ValidateUse(useAmount, orderAmount, userPoint.AvailableBalance); err != nil {
+		// 2. 같은 주문으로 이미 사용했는지 확인
+		orderTransactions, err := uc.repo.GetTransactionsByOrderID(txCtx, orderID)
+		if err != nil {
 			return err
 		}
+		for _, tx := range orderTransactions {
+			if tx.IsPurchaseUse() {
+				return point.ErrOrderAlreadyUsed
+			}
+		}
 
-		// 3. FIFO 방식으로 적립 내역에서 차감
-		earnedTransactions, err := uc.repo.GetEarnedTransactions(txCtx, userID, 100)
+		// 3. 사용 유효성 검증 (회원 등급별 정책 적용)
+		_, policy, err := resolvePolicy(txCtx, uc.repo, uc.policy, userID)
 		if err != nil {
 			return err
 		}
-
-		remainingAmount := useAmount
-		for _, tx := range earnedTransactions {
-			if remainingAmount <= 0 {
-				break
-			}
-
-			availableAmount := tx.Amount
-			if availableAmount > remainingAmount {
-				availableAmount = remainingAmount
-			}
-
-			remainingAmount -= availableAmount
+		if err := policy.ValidateUse(useAmount, orderAmount, userPoint.AvailableBalance); err != nil {
+			return err
 		}
 
-		if remainingAmount > 0 {
-			return point.ErrInsufficientPoints
+		// 4. 지갑 사용 순서대로 적립 lot 에서 차감할 금액 계산 (같은 지갑은 FIFO)
+		plan, err := planLotConsumption(txCtx, uc.repo, userID, useAmount, policy.WalletUseOrder)
+		if err != nil {
+			return err
 		}
 
-		// 4. 포인트 차감 (도메인 로직)
+		// 5. 포인트 차감 (도메인 로직)
 		if err := userPoint.Use(useAmount); err != nil {
 			return err
 		}
 
-		// 5. 사용 거래 내역 생성
+		// 6. 사용 거래 내역 생성
 		transaction := &point.Transaction{
 			UserID:       userID,
 			Type:         point.TransactionTypeUse,
@@ -81,8 +81,17 @@ func (uc *UsePointsUseCase) UsePoints(ctx context.Context, userID int64, useAmou
 		if err := uc.repo.CreateTransaction(txCtx, transaction); err != nil {
 			return err
 		}
+		if err := recordEvent(txCtx, uc.repo, point.EventTypeUsed, transaction); err != nil {
+			return err
+		}
+
+		// 7. 적립 lot 잔여 포인트 차감 및 차감 내역 기록
+		if err := plan.save(txCtx, uc.repo, transaction.ID); err != nil {
+			return err
+		}
 
-		// 6. 잔액 업데이트
+		// 8. 잔액 업데이트
+		invalidateBalance(txCtx, uc.tm, uc.cache, userPoint.UserID)
 		return uc.repo.UpdateUserPoint(txCtx, userPoint)
 	})
 }
@@ -0,0 +1,190 @@
+package point
+
+import (
+	"context"
+	"shopping-mall/internal/domain/point"
+	"time"
+)
+
+// WebhookUseCase 포인트 이벤트 웹훅 구독/전송 유스케이스
+type WebhookUseCase struct {
+	repo   point.Repository
+	sender point.WebhookSender
+	policy point.DispatchPolicy
+}
+
+// NewWebhookUseCase 웹훅 유스케이스 생성
+func NewWebhookUseCase(repo point.Repository, sender point.WebhookSender, policy point.DispatchPolicy) *WebhookUseCase {
+	return &WebhookUseCase{
+		repo:   repo,
+		sender: sender,
+		policy: policy,
+	}
+}
+
+// WebhookUpdate 웹훅 구독 변경 내용 (nil 이면 변경하지 않음)
+type WebhookUpdate struct {
+	URL          *string
+	EventTypes   *[]point.EventType
+	Description  *string
+	Active       *bool
+	RotateSecret bool // 서명 비밀키 재발급
+}
+
+// WebhookDeliveryResult 웹훅 전송 결과
+type WebhookDeliveryResult struct {
+	Fetched   int // 조회한 전송 내역 수
+	Delivered int // 전송 완료
+	Retrying  int // 전송 실패 후 재시도 대기
+	Dead      int // 최대 시도 횟수 초과 또는 구독 비활성으로 dead letter
+}
+
+// CreateSubscription 웹훅 구독 생성 (비밀키가 없으면 생성)
+func (uc *WebhookUseCase) CreateSubscription(ctx context.Context, subscription *point.WebhookSubscription) error {
+	if subscription.Secret == "" {
+		subscription.Secret = point.NewWebhookSecret()
+	}
+	if err := subscription.Validate(); err != nil {
+		return err
+	}
+	subscription.Active = true
+	return uc.repo.CreateWebhookSubscription(ctx, subscription)
+}
+
+// UpdateSubscription 웹훅 구독 변경
+func (uc *WebhookUseCase) UpdateSubscription(ctx context.Context, id int64, update *WebhookUpdate) (*point.WebhookSubscription, error) {
+	subscription, err := uc.repo.GetWebhookSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if update.URL != nil {
+		subscription.URL = *update.URL
+	}
+	if update.EventTypes != nil {
+		subscription.EventTypes = *update.EventTypes
+	}
+	if update.Description != nil {
+		subscription.Description = *update.Description
+	}
+	if update.Active != nil {
+		subscription.Active = *update.Active
+	}
+	if update.RotateSecret {
+		subscription.Secret = point.NewWebhookSecret()
+	}
+	if err := subscription.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.UpdateWebhookSubscription(ctx, subscription); err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+// GetSubscription 웹훅 구독 조회
+func (uc *WebhookUseCase) GetSubscription(ctx context.Context, id int64) (*point.WebhookSubscription, error) {
+	return uc.repo.GetWebhookSubscription(ctx, id)
+}
+
+// ListSubscriptions 웹훅 구독 목록 조회
+func (uc *WebhookUseCase) ListSubscriptions(ctx context.Context, limit, offset int) ([]*point.WebhookSubscription, error) {
+	return uc.repo.GetWebhookSubscriptions(ctx, limit, offset)
+}
+
+// ListDeliveries 구독의 웹훅 전송 내역 조회
+func (uc *WebhookUseCase) ListDeliveries(ctx context.Context, subscriptionID int64, status point.WebhookDeliveryStatus, limit, offset int) ([]*point.WebhookDelivery, error) {
+	if _, err := uc.repo.GetWebhookSubscription(ctx, subscriptionID); err != nil {
+		return nil, err
+	}
+	return uc.repo.GetWebhookDeliveries(ctx, subscriptionID, status, limit, offset)
+}
+
+// Redeliver 웹훅 재전송 요청 (상태와 관계없이 시도 횟수를 초기화하고 다음 전송 주기에 다시 전송)
+func (uc *WebhookUseCase) Redeliver(ctx context.Context, deliveryID int64) (*point.WebhookDelivery, error) {
+	delivery, err := uc.repo.GetWebhookDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery.Redeliver(time.Now())
+	if err := uc.repo.UpdateWebhookDelivery(ctx, delivery); err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+// Publish outbox 이벤트를 구독하는 활성 웹훅마다 전송 내역 생성 (point.EventPublisher 구현)
+// 같은 이벤트가 다시 발행되어도 구독마다 전송 내역은 하나만 생성
+func (uc *WebhookUseCase) Publish(ctx context.Context, event *point.OutboxEvent) error {
+	subscriptions, err := uc.repo.GetActiveWebhookSubscriptions(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, subscription := range subscriptions {
+		if !subscription.Matches(event.EventType) {
+			continue
+		}
+		if err := uc.repo.CreateWebhookDelivery(ctx, point.NewWebhookDelivery(subscription, event, now)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeliverDue now 시점에 전송할 웹훅을 최대 limit 개 전송
+// 실패하면 재시도 정책에 따라 지수 백오프로 재시도하고, 최대 시도 횟수를 넘으면 dead letter 로 보관
+func (uc *WebhookUseCase) DeliverDue(ctx context.Context, now time.Time, limit int) (*WebhookDeliveryResult, error) {
+	// 1. 전송할 내역 조회
+	deliveries, err := uc.repo.GetDueWebhookDeliveries(ctx, now, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &WebhookDeliveryResult{Fetched: len(deliveries)}
+	subscriptions := make(map[int64]*point.WebhookSubscription)
+	for _, delivery := range deliveries {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		// 2. 구독 조회 (비활성/삭제된 구독은 전송하지 않고 dead letter)
+		subscription, ok := subscriptions[delivery.SubscriptionID]
+		if !ok {
+			subscription, err = uc.repo.GetWebhookSubscription(ctx, delivery.SubscriptionID)
+			if err != nil && err != point.ErrWebhookNotFound {
+				return result, err
+			}
+			subscriptions[delivery.SubscriptionID] = subscription
+		}
+
+		// 3. 서명 후 전송 및 결과 기록
+		switch {
+		case subscription == nil || !subscription.Active:
+			delivery.MarkDead("webhook subscription is inactive")
+			result.Dead++
+		default:
+			statusCode, err := uc.sender.Send(ctx, subscription, delivery)
+			if err != nil {
+				delivery.MarkFailed(statusCode, err, time.Now(), uc.policy)
+				if delivery.Status == point.WebhookDeliveryDead {
+					result.Dead++
+				} else {
+					result.Retrying++
+				}
+			} else {
+				delivery.MarkDelivered(statusCode, time.Now())
+				result.Delivered++
+			}
+		}
+
+		if err := uc.repo.UpdateWebhookDelivery(ctx, delivery); err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,195 @@
+package point
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"shopping-mall/internal/domain/point"
+	"shopping-mall/internal/infrastructure/webhook"
+)
+
+const testWebhookSecret = "whsec_test_0123456789abcdef"
+
+// webhookReceiver 서명을 검증하고 지정한 상태 코드로 응답하는 httptest 수신기
+type webhookReceiver struct {
+	mu       sync.Mutex
+	status   int
+	received int
+	invalid  int
+}
+
+func (rc *webhookReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.received++
+	if err := webhook.Verify(r, body, testWebhookSecret, 5*time.Minute); err != nil {
+		rc.invalid++
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	w.WriteHeader(rc.status)
+}
+
+func (rc *webhookReceiver) respond(status int) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.status = status
+}
+
+func newWebhookTest(t *testing.T, status int, active bool) (*WebhookUseCase, *fakeRepository, *webhookReceiver) {
+	t.Helper()
+
+	receiver := &webhookReceiver{status: status}
+	server := httptest.NewServer(receiver)
+	t.Cleanup(server.Close)
+
+	repo := newFakeRepository()
+	repo.subscriptions[1] = &point.WebhookSubscription{ID: 1, URL: server.URL, Secret: testWebhookSecret, Active: active}
+	repo.deliveries[1] = &point.WebhookDelivery{
+		ID:             1,
+		SubscriptionID: 1,
+		EventID:        "evt-1",
+		EventType:      point.EventTypeEarned,
+		Payload:        []byte(`{"event_id":"evt-1"}`),
+		Status:         point.WebhookDeliveryPending,
+		NextAttemptAt:  time.Now().Add(-time.Second),
+	}
+
+	policy := point.DispatchPolicy{MaxAttempts: 3, BaseBackoff: time.Minute, MaxBackoff: time.Hour}
+	uc := NewWebhookUseCase(repo, webhook.NewSender(time.Second).WithHTTPClient(server.Client()), policy)
+	return uc, repo, receiver
+}
+
+func TestDeliverDueDelivered(t *testing.T) {
+	uc, repo, receiver := newWebhookTest(t, http.StatusOK, true)
+
+	result, err := uc.DeliverDue(context.Background(), time.Now(), 10)
+	if err != nil {
+		t.Fatalf("DeliverDue() error = %v", err)
+	}
+	if result.Fetched != 1 || result.Delivered != 1 {
+		t.Errorf("result = %+v, want 1 delivered", result)
+	}
+	if receiver.received != 1 || receiver.invalid != 0 {
+		t.Errorf("receiver got %d requests, %d with invalid signature", receiver.received, receiver.invalid)
+	}
+
+	delivery := repo.deliveries[1]
+	if delivery.Status != point.WebhookDeliveryDelivered || delivery.LastStatusCode != http.StatusOK || delivery.DeliveredAt == nil {
+		t.Errorf("delivery = %+v, want DELIVERED", delivery)
+	}
+}
+
+func TestDeliverDueRetriesWithBackoffThenDeadLetters(t *testing.T) {
+	uc, repo, receiver := newWebhookTest(t, http.StatusInternalServerError, true)
+	ctx := context.Background()
+
+	wantBackoff := []time.Duration{time.Minute, 2 * time.Minute}
+	for attempt, backoff := range wantBackoff {
+		sentAt := time.Now()
+		result, err := uc.DeliverDue(ctx, sentAt, 10)
+		if err != nil {
+			t.Fatalf("attempt %d: DeliverDue() error = %v", attempt+1, err)
+		}
+		if result.Retrying != 1 {
+			t.Fatalf("attempt %d: result = %+v, want 1 retrying", attempt+1, result)
+		}
+
+		delivery := repo.deliveries[1]
+		if delivery.Attempts != attempt+1 || delivery.LastStatusCode != http.StatusInternalServerError {
+			t.Errorf("attempt %d: attempts %d code %d", attempt+1, delivery.Attempts, delivery.LastStatusCode)
+		}
+		if delay := delivery.NextAttemptAt.Sub(sentAt); delay < backoff || delay > backoff+time.Second {
+			t.Errorf("attempt %d: next attempt in %v, want %v", attempt+1, delay, backoff)
+		}
+
+		// 백오프가 끝나기 전에는 다시 전송하지 않음
+		result, err = uc.DeliverDue(ctx, sentAt, 10)
+		if err != nil || result.Fetched != 0 {
+			t.Fatalf("attempt %d: DeliverDue() before backoff = %+v, %v", attempt+1, result, err)
+		}
+
+		repo.deliveries[1].NextAttemptAt = time.Now().Add(-time.Second)
+	}
+
+	result, err := uc.DeliverDue(ctx, time.Now(), 10)
+	if err != nil {
+		t.Fatalf("DeliverDue() error = %v", err)
+	}
+	if result.Dead != 1 || repo.deliveries[1].Status != point.WebhookDeliveryDead {
+		t.Fatalf("after max attempts result = %+v, status %s", result, repo.deliveries[1].Status)
+	}
+	if receiver.received != 3 {
+		t.Errorf("receiver got %d requests, want 3", receiver.received)
+	}
+
+	// dead letter 는 전송 대상에서 제외
+	result, err = uc.DeliverDue(ctx, time.Now().Add(24*time.Hour), 10)
+	if err != nil || result.Fetched != 0 {
+		t.Errorf("DeliverDue() after dead letter = %+v, %v", result, err)
+	}
+}
+
+func TestRedeliverDeadLetter(t *testing.T) {
+	uc, repo, receiver := newWebhookTest(t, http.StatusOK, true)
+	ctx := context.Background()
+	repo.deliveries[1].Status = point.WebhookDeliveryDead
+	repo.deliveries[1].Attempts = 3
+
+	delivery, err := uc.Redeliver(ctx, 1)
+	if err != nil {
+		t.Fatalf("Redeliver() error = %v", err)
+	}
+	if delivery.Status != point.WebhookDeliveryPending || delivery.Attempts != 0 {
+		t.Errorf("redelivered = status %s attempts %d", delivery.Status, delivery.Attempts)
+	}
+
+	result, err := uc.DeliverDue(ctx, time.Now(), 10)
+	if err != nil || result.Delivered != 1 {
+		t.Fatalf("DeliverDue() after redeliver = %+v, %v", result, err)
+	}
+	if receiver.received != 1 || repo.deliveries[1].Status != point.WebhookDeliveryDelivered {
+		t.Errorf("receiver got %d requests, status %s", receiver.received, repo.deliveries[1].Status)
+	}
+
+	if _, err := uc.Redeliver(ctx, 99); err != point.ErrWebhookDeliveryNotFound {
+		t.Errorf("Redeliver(unknown) error = %v, want %v", err, point.ErrWebhookDeliveryNotFound)
+	}
+}
+
+func TestDeliverDueInactiveSubscription(t *testing.T) {
+	tests := []struct {
+		name   string
+		remove bool
+	}{
+		{"inactive subscription", false},
+		{"deleted subscription", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo, receiver := newWebhookTest(t, http.StatusOK, false)
+			if tt.remove {
+				delete(repo.subscriptions, 1)
+			}
+
+			result, err := uc.DeliverDue(context.Background(), time.Now(), 10)
+			if err != nil {
+				t.Fatalf("DeliverDue() error = %v", err)
+			}
+			if result.Dead != 1 || repo.deliveries[1].Status != point.WebhookDeliveryDead {
+				t.Errorf("result = %+v, status %s, want dead letter", result, repo.deliveries[1].Status)
+			}
+			if receiver.received != 0 {
+				t.Errorf("receiver got %d requests, want 0", receiver.received)
+			}
+		})
+	}
+}
@@ -0,0 +1,2 @@
+-- user_points 테이블 삭제
+DROP TABLE IF EXISTS user_points;
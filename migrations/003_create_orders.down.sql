@@ -0,0 +1,2 @@
+-- orders 테이블 삭제
+DROP TABLE IF EXISTS orders;
@@ -0,0 +1,6 @@
+-- point_allocations 테이블 삭제
+DROP TABLE IF EXISTS point_allocations;
+
+-- 적립 lot 별 미사용 잔여 포인트 컬럼 삭제
+ALTER TABLE point_transactions
+    DROP COLUMN remaining_amount;
@@ -0,0 +1,27 @@
+-- 적립 lot 별 미사용 잔여 포인트 컬럼 추가
+ALTER TABLE point_transactions
+    ADD COLUMN remaining_amount BIGINT NULL COMMENT '미사용 잔여 포인트 (EARN lot)' AFTER amount;
+
+-- 기존 거래 내역 잔여 포인트 초기화 (이미 채워진 행은 건드리지 않음)
+UPDATE point_transactions
+SET remaining_amount = CASE
+        WHEN transaction_type = 'EARN' AND expired = FALSE AND status = 'CONFIRMED' THEN amount
+        ELSE 0
+    END
+WHERE remaining_amount IS NULL;
+
+-- point_allocations 테이블 생성 (사용 거래 → 적립 lot 차감 내역)
+CREATE TABLE IF NOT EXISTS point_allocations (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT NOT NULL COMMENT '사용자 ID',
+    use_transaction_id BIGINT NOT NULL COMMENT '사용 거래 ID',
+    earn_transaction_id BIGINT NOT NULL COMMENT '차감된 적립 거래 ID',
+    amount BIGINT NOT NULL COMMENT '차감 금액',
+    restored_amount BIGINT NOT NULL DEFAULT 0 COMMENT '환불로 복구된 금액',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_user_id (user_id),
+    INDEX idx_use_transaction_id (use_transaction_id),
+    INDEX idx_earn_transaction_id (earn_transaction_id),
+    FOREIGN KEY (use_transaction_id) REFERENCES point_transactions(id) ON DELETE CASCADE,
+    FOREIGN KEY (earn_transaction_id) REFERENCES point_transactions(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='포인트 사용 lot 차감 내역';
@@ -0,0 +1,6 @@
+-- 적립 확정 배치 조회용 인덱스 삭제
+DROP INDEX idx_type_status_scheduled_at ON point_transactions;
+
+-- 적립 확정 예정일 컬럼 삭제
+ALTER TABLE point_transactions
+    DROP COLUMN scheduled_at;
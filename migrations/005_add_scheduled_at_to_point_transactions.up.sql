@@ -0,0 +1,6 @@
+-- 적립 예정 거래의 적립 확정 예정일 컬럼 추가
+ALTER TABLE point_transactions
+    ADD COLUMN scheduled_at TIMESTAMP NULL COMMENT '적립 확정 예정일 (PENDING 적립)' AFTER earned_at;
+
+-- 적립 확정 배치 조회용 인덱스
+CREATE INDEX idx_type_status_scheduled_at ON point_transactions (transaction_type, status, scheduled_at);
@@ -0,0 +1,2 @@
+-- idempotency_keys 테이블 삭제
+DROP TABLE IF EXISTS idempotency_keys;
@@ -0,0 +1,14 @@
+-- idempotency_keys 테이블 생성 (포인트 변경 요청 재시도 중복 방지)
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    scope VARCHAR(255) NOT NULL COMMENT '요청 범위 (메서드, 경로, 사용자)',
+    idempotency_key VARCHAR(255) NOT NULL COMMENT 'Idempotency-Key 헤더 또는 주문 기반 자연 키',
+    request_hash CHAR(64) NOT NULL COMMENT '요청 페이로드 SHA-256 해시',
+    response_status INT NOT NULL DEFAULT 0 COMMENT '기록된 응답 상태 코드',
+    response_body TEXT NULL COMMENT '기록된 응답 본문',
+    expires_at TIMESTAMP NOT NULL COMMENT '만료 시각 (지나면 같은 키를 새 요청으로 처리하고 Worker 가 삭제)',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_scope_key (scope, idempotency_key),
+    INDEX idx_created_at (created_at),
+    INDEX idx_expires_at (expires_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='멱등성 키';
@@ -0,0 +1,6 @@
+-- point_reservations 테이블 삭제
+DROP TABLE IF EXISTS point_reservations;
+
+-- 예약(보류) 포인트 잔액 컬럼 삭제
+ALTER TABLE user_points
+    DROP COLUMN held_balance;
@@ -0,0 +1,21 @@
+-- 예약(보류) 포인트 잔액 컬럼 추가
+ALTER TABLE user_points
+    ADD COLUMN held_balance BIGINT NOT NULL DEFAULT 0 COMMENT '결제 대기 중 예약된 포인트' AFTER pending_balance;
+
+-- point_reservations 테이블 생성 (결제 전 포인트 예약)
+CREATE TABLE IF NOT EXISTS point_reservations (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT NOT NULL COMMENT '사용자 ID',
+    order_id BIGINT NOT NULL COMMENT '주문 ID',
+    use_transaction_id BIGINT NOT NULL COMMENT '예약으로 생성된 사용 거래 ID',
+    amount BIGINT NOT NULL COMMENT '예약 포인트',
+    order_amount BIGINT NOT NULL COMMENT '주문 금액',
+    status ENUM('HELD', 'CAPTURED', 'RELEASED', 'EXPIRED') NOT NULL DEFAULT 'HELD' COMMENT '예약 상태',
+    expires_at TIMESTAMP NOT NULL COMMENT '예약 만료 시각',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_user_id (user_id),
+    INDEX idx_order_id (order_id),
+    INDEX idx_status_expires_at (status, expires_at),
+    FOREIGN KEY (use_transaction_id) REFERENCES point_transactions(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='포인트 예약';
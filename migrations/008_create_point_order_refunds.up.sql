@@ -0,0 +1,16 @@
+-- point_order_refunds 테이블 생성 (주문별 부분 환불 누적 내역)
+CREATE TABLE IF NOT EXISTS point_order_refunds (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    order_id BIGINT NOT NULL COMMENT '주문 ID',
+    user_id BIGINT NOT NULL COMMENT '사용자 ID',
+    payment_amount BIGINT NOT NULL COMMENT '원 결제 금액',
+    used_point_amount BIGINT NOT NULL DEFAULT 0 COMMENT '원 사용 포인트',
+    earned_point_amount BIGINT NOT NULL DEFAULT 0 COMMENT '원 구매 적립 포인트',
+    refunded_payment_amount BIGINT NOT NULL DEFAULT 0 COMMENT '누적 환불 결제 금액',
+    restored_point_amount BIGINT NOT NULL DEFAULT 0 COMMENT '누적 복구 사용 포인트',
+    clawed_back_point_amount BIGINT NOT NULL DEFAULT 0 COMMENT '누적 회수 적립 포인트',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_order_id (order_id),
+    INDEX idx_user_id (user_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='주문별 포인트 부분 환불 내역';
@@ -0,0 +1,6 @@
+-- 부채 리포트 조회용 인덱스 삭제
+DROP INDEX idx_debt_balance ON user_points;
+
+-- 포인트 부채 컬럼 삭제
+ALTER TABLE user_points
+    DROP COLUMN debt_balance;
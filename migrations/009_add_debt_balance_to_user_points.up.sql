@@ -0,0 +1,6 @@
+-- 포인트 부채 컬럼 추가 (환불 회수 시 부족분, 이후 적립에서 먼저 상계)
+ALTER TABLE user_points
+    ADD COLUMN debt_balance BIGINT NOT NULL DEFAULT 0 COMMENT '회수하지 못한 포인트 부채' AFTER held_balance;
+
+-- 부채 리포트 조회용 인덱스
+CREATE INDEX idx_debt_balance ON user_points (debt_balance);
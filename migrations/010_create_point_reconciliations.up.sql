@@ -0,0 +1,19 @@
+-- point_reconciliations 테이블 생성 (잔액-거래 내역 정합성 검증 불일치 리포트)
+CREATE TABLE IF NOT EXISTS point_reconciliations (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT NOT NULL COMMENT '사용자 ID',
+    expected_net_balance BIGINT NOT NULL COMMENT '거래 내역 기준 순잔액 (사용 가능 - 부채)',
+    actual_net_balance BIGINT NOT NULL COMMENT '잔액 테이블 순잔액 (사용 가능 - 부채)',
+    expected_pending_balance BIGINT NOT NULL COMMENT '거래 내역 기준 적립 예정 포인트',
+    actual_pending_balance BIGINT NOT NULL COMMENT '잔액 테이블 적립 예정 포인트',
+    expected_held_balance BIGINT NOT NULL COMMENT '거래 내역 기준 예약 포인트',
+    actual_held_balance BIGINT NOT NULL COMMENT '잔액 테이블 예약 포인트',
+    expected_total_earned BIGINT NOT NULL COMMENT '거래 내역 기준 누적 적립',
+    actual_total_earned BIGINT NOT NULL COMMENT '잔액 테이블 누적 적립',
+    expected_total_used BIGINT NOT NULL COMMENT '거래 내역 기준 누적 사용',
+    actual_total_used BIGINT NOT NULL COMMENT '잔액 테이블 누적 사용',
+    corrected BOOLEAN NOT NULL DEFAULT FALSE COMMENT '보정 거래로 자동 보정 여부',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_user_id (user_id),
+    INDEX idx_created_at (created_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='포인트 정합성 검증 불일치 리포트';
@@ -0,0 +1,7 @@
+-- 운영자별 조정 내역 조회용 인덱스 삭제
+DROP INDEX idx_operator_id ON point_transactions;
+
+-- 관리자 수동 지급/차감 감사 정보 컬럼 삭제
+ALTER TABLE point_transactions
+    DROP COLUMN ticket_ref,
+    DROP COLUMN operator_id;
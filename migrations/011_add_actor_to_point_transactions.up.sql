@@ -0,0 +1,7 @@
+-- 관리자 수동 지급/차감 감사 정보 컬럼 추가 (처리 운영자, 고객 문의 티켓)
+ALTER TABLE point_transactions
+    ADD COLUMN operator_id VARCHAR(64) NULL COMMENT '처리 운영자 ID (관리자 수동 조정)' AFTER reason_detail,
+    ADD COLUMN ticket_ref VARCHAR(64) NULL COMMENT '고객 문의 티켓 번호 (관리자 수동 조정)' AFTER operator_id;
+
+-- 운영자별 조정 내역 조회용 인덱스
+CREATE INDEX idx_operator_id ON point_transactions (operator_id);
@@ -0,0 +1,2 @@
+-- point_adjustment_approvals 테이블 삭제
+DROP TABLE IF EXISTS point_adjustment_approvals;
@@ -0,0 +1,22 @@
+-- point_adjustment_approvals 테이블 생성 (관리자 포인트 조정 승인 요청)
+CREATE TABLE IF NOT EXISTS point_adjustment_approvals (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    direction ENUM('CREDIT', 'DEBIT') NOT NULL COMMENT '지급/차감 구분',
+    user_id BIGINT NOT NULL COMMENT '대상 사용자 ID',
+    amount BIGINT NOT NULL COMMENT '조정 포인트',
+    reason VARCHAR(255) NOT NULL COMMENT '조정 사유',
+    ticket_ref VARCHAR(64) NOT NULL COMMENT '고객 문의 티켓 번호',
+    expires_in_days INT NOT NULL DEFAULT 0 COMMENT '지급 포인트 유효기간 (일, 0 이면 기본 정책)',
+    requested_by VARCHAR(64) NOT NULL COMMENT '요청 운영자 ID',
+    reviewed_by VARCHAR(64) NULL COMMENT '승인/반려 운영자 ID',
+    review_note VARCHAR(255) NULL COMMENT '승인/반려 메모',
+    transaction_id BIGINT NULL COMMENT '승인으로 생성된 거래 ID',
+    status ENUM('PENDING', 'APPROVED', 'REJECTED', 'EXPIRED') NOT NULL DEFAULT 'PENDING' COMMENT '승인 상태',
+    expires_at TIMESTAMP NOT NULL COMMENT '승인 요청 만료 시각',
+    reviewed_at TIMESTAMP NULL COMMENT '승인/반려/만료 시각',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_user_id (user_id),
+    INDEX idx_status_expires_at (status, expires_at),
+    FOREIGN KEY (transaction_id) REFERENCES point_transactions(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='관리자 포인트 조정 승인 요청';
@@ -0,0 +1,3 @@
+-- user_tiers, membership_tier_policies 테이블 삭제
+DROP TABLE IF EXISTS user_tiers;
+DROP TABLE IF EXISTS membership_tier_policies;
@@ -0,0 +1,22 @@
+-- membership_tier_policies 테이블 생성 (회원 등급별 정책 재정의, NULL 이면 기본 정책 사용)
+CREATE TABLE IF NOT EXISTS membership_tier_policies (
+    tier ENUM('BRONZE', 'SILVER', 'GOLD', 'VIP') NOT NULL PRIMARY KEY COMMENT '회원 등급',
+    earn_rate DECIMAL(5, 4) NULL COMMENT '적립률 (0.05 = 5%)',
+    max_earn_per_order BIGINT NULL COMMENT '주문당 최대 적립',
+    max_use_rate DECIMAL(5, 4) NULL COMMENT '최대 사용 비율 (0.5 = 50%)',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='회원 등급별 포인트 정책';
+
+INSERT INTO membership_tier_policies (tier, earn_rate, max_earn_per_order, max_use_rate) VALUES
+    ('BRONZE', NULL, NULL, NULL),
+    ('SILVER', 0.0600, NULL, NULL),
+    ('GOLD', 0.0700, NULL, 0.6000),
+    ('VIP', 0.1000, 100000, 0.7000);
+
+-- user_tiers 테이블 생성 (사용자 회원 등급, 행이 없으면 BRONZE)
+CREATE TABLE IF NOT EXISTS user_tiers (
+    user_id BIGINT NOT NULL PRIMARY KEY COMMENT '사용자 ID',
+    tier ENUM('BRONZE', 'SILVER', 'GOLD', 'VIP') NOT NULL DEFAULT 'BRONZE' COMMENT '회원 등급',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_tier (tier)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='사용자 회원 등급';
@@ -0,0 +1,13 @@
+-- user_tier_histories 테이블 삭제
+DROP TABLE IF EXISTS user_tier_histories;
+
+-- 등급 승급 보너스 사유 삭제 (보너스 거래는 관리자 사유로 변경)
+UPDATE point_transactions SET reason_type = 'ADMIN' WHERE reason_type = 'GRADE';
+ALTER TABLE point_transactions
+    MODIFY COLUMN reason_type ENUM('PURCHASE', 'REVIEW', 'SIGNUP', 'REFUND', 'ADMIN') NOT NULL COMMENT '적립/사용 사유';
+
+-- 회원 등급 산정 컬럼 삭제
+ALTER TABLE user_tiers
+    DROP COLUMN evaluated_at,
+    DROP COLUMN downgrade_at,
+    DROP COLUMN spend_amount;
@@ -0,0 +1,22 @@
+-- 회원 등급 산정 컬럼 추가
+ALTER TABLE user_tiers
+    ADD COLUMN spend_amount BIGINT NOT NULL DEFAULT 0 COMMENT '마지막 등급 산정 시 구매 실적' AFTER tier,
+    ADD COLUMN downgrade_at TIMESTAMP NULL COMMENT '하향 유예 기간 종료 시각' AFTER spend_amount,
+    ADD COLUMN evaluated_at TIMESTAMP NULL COMMENT '마지막 등급 산정 시각' AFTER downgrade_at;
+
+-- 등급 승급 보너스 사유 추가
+ALTER TABLE point_transactions
+    MODIFY COLUMN reason_type ENUM('PURCHASE', 'REVIEW', 'SIGNUP', 'REFUND', 'ADMIN', 'GRADE') NOT NULL COMMENT '적립/사용 사유';
+
+-- user_tier_histories 테이블 생성 (회원 등급 변경 이력)
+CREATE TABLE IF NOT EXISTS user_tier_histories (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT NOT NULL COMMENT '사용자 ID',
+    from_tier ENUM('BRONZE', 'SILVER', 'GOLD', 'VIP') NOT NULL COMMENT '변경 전 등급',
+    to_tier ENUM('BRONZE', 'SILVER', 'GOLD', 'VIP') NOT NULL COMMENT '변경 후 등급',
+    spend_amount BIGINT NOT NULL COMMENT '등급 산정 기준 구매 실적',
+    bonus_transaction_id BIGINT NULL COMMENT '승급 보너스 거래 ID',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_user_id_created_at (user_id, created_at),
+    FOREIGN KEY (bonus_transaction_id) REFERENCES point_transactions(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='회원 등급 변경 이력';
@@ -0,0 +1,7 @@
+-- 캠페인 ID 컬럼 삭제
+ALTER TABLE point_transactions
+    DROP INDEX idx_campaign_user,
+    DROP COLUMN campaign_id;
+
+-- point_campaigns 테이블 삭제
+DROP TABLE IF EXISTS point_campaigns;
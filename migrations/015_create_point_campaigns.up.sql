@@ -0,0 +1,24 @@
+-- point_campaigns 테이블 생성 (기간 한정 프로모션 적립 캠페인)
+CREATE TABLE IF NOT EXISTS point_campaigns (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(100) NOT NULL COMMENT '캠페인 이름',
+    bonus_type ENUM('MULTIPLIER', 'RATE', 'FLAT') NOT NULL COMMENT '추가 적립 방식',
+    bonus_value DECIMAL(12, 4) NOT NULL COMMENT '배수 / 추가 적립률 / 고정 포인트',
+    starts_at TIMESTAMP NOT NULL COMMENT '시작 시각',
+    ends_at TIMESTAMP NOT NULL COMMENT '종료 시각 (미포함)',
+    tiers JSON NULL COMMENT '대상 회원 등급 (NULL 이면 전체)',
+    reason_types JSON NULL COMMENT '대상 적립 사유 (NULL 이면 전체)',
+    categories JSON NULL COMMENT '대상 상품 카테고리 (NULL 이면 전체)',
+    min_payment_amount BIGINT NOT NULL DEFAULT 0 COMMENT '최소 결제 금액',
+    user_budget BIGINT NOT NULL DEFAULT 0 COMMENT '사용자별 최대 추가 적립 (0 이면 제한 없음)',
+    total_budget BIGINT NOT NULL DEFAULT 0 COMMENT '전체 최대 추가 적립 (0 이면 제한 없음)',
+    spent_budget BIGINT NOT NULL DEFAULT 0 COMMENT '지급한 추가 적립 누계',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_period (starts_at, ends_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='프로모션 적립 캠페인';
+
+-- 캠페인 추가 적립 거래의 캠페인 ID 컬럼 추가
+ALTER TABLE point_transactions
+    ADD COLUMN campaign_id BIGINT NULL COMMENT '프로모션 캠페인 ID' AFTER ticket_ref,
+    ADD INDEX idx_campaign_user (campaign_id, user_id);
@@ -0,0 +1,5 @@
+-- point_order_lines 테이블 삭제
+DROP TABLE IF EXISTS point_order_lines;
+
+-- point_earn_rules 테이블 삭제
+DROP TABLE IF EXISTS point_earn_rules;
@@ -0,0 +1,32 @@
+-- point_earn_rules 테이블 생성 (카테고리/상품별 적립 규칙, SKU 규칙이 카테고리 규칙보다 우선)
+CREATE TABLE IF NOT EXISTS point_earn_rules (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    scope ENUM('CATEGORY', 'SKU') NOT NULL COMMENT '적용 범위',
+    target VARCHAR(100) NOT NULL COMMENT '카테고리 또는 SKU',
+    earn_rate DECIMAL(5, 4) NULL COMMENT '적립률 (NULL 이면 회원 등급 적립률)',
+    excluded BOOLEAN NOT NULL DEFAULT FALSE COMMENT '적립 제외 여부',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_scope_target (scope, target)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='카테고리/상품별 적립 규칙';
+
+-- point_order_lines 테이블 생성 (구매 적립 시 주문 라인별 적립 내역)
+CREATE TABLE IF NOT EXISTS point_order_lines (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    order_id BIGINT NOT NULL COMMENT '주문 ID',
+    user_id BIGINT NOT NULL COMMENT '사용자 ID',
+    line_no INT NOT NULL COMMENT '주문 내 라인 번호',
+    sku VARCHAR(100) NOT NULL DEFAULT '' COMMENT '상품 코드',
+    category VARCHAR(100) NOT NULL DEFAULT '' COMMENT '상품 카테고리',
+    quantity BIGINT NOT NULL COMMENT '수량',
+    unit_price BIGINT NOT NULL COMMENT '단가',
+    discount BIGINT NOT NULL DEFAULT 0 COMMENT '라인 할인 금액',
+    point_eligible BOOLEAN NOT NULL DEFAULT TRUE COMMENT '적립 대상 여부',
+    earn_rate DECIMAL(5, 4) NOT NULL COMMENT '적용된 적립률',
+    earned_points BIGINT NOT NULL COMMENT '라인 적립 포인트',
+    refunded_quantity BIGINT NOT NULL DEFAULT 0 COMMENT '누적 환불 수량',
+    clawed_back_points BIGINT NOT NULL DEFAULT 0 COMMENT '누적 회수 포인트',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_order_line (order_id, line_no)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='주문 라인별 적립 내역';
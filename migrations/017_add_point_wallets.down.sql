@@ -0,0 +1,12 @@
+-- 캠페인 추가 적립 지갑 컬럼 삭제
+ALTER TABLE point_campaigns
+    DROP COLUMN wallet;
+
+-- 관리자 조정 승인 요청의 지급 지갑 컬럼 삭제
+ALTER TABLE point_adjustment_approvals
+    DROP COLUMN wallet;
+
+-- 포인트 지갑 컬럼 삭제
+ALTER TABLE point_transactions
+    DROP INDEX idx_user_wallet,
+    DROP COLUMN wallet;
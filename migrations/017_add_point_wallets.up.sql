@@ -0,0 +1,15 @@
+-- 적립 lot 의 포인트 지갑 컬럼 추가 (REGULAR 일반, EVENT 이벤트, CASH 현금성)
+ALTER TABLE point_transactions
+    ADD COLUMN wallet ENUM('REGULAR', 'EVENT', 'CASH') NULL COMMENT '포인트 지갑 (EARN lot, EXPIRE 거래, 그 외 거래는 NULL)' AFTER campaign_id,
+    ADD INDEX idx_user_wallet (user_id, wallet);
+
+-- 기존 적립 lot 은 일반 지갑으로 이관
+UPDATE point_transactions SET wallet = 'REGULAR' WHERE transaction_type = 'EARN';
+
+-- 관리자 조정 승인 요청의 지급 지갑 컬럼 추가
+ALTER TABLE point_adjustment_approvals
+    ADD COLUMN wallet ENUM('REGULAR', 'EVENT', 'CASH') NULL COMMENT '지급 지갑 (NULL 이면 일반 지갑)' AFTER expires_in_days;
+
+-- 캠페인 추가 적립 지갑 컬럼 추가
+ALTER TABLE point_campaigns
+    ADD COLUMN wallet ENUM('REGULAR', 'EVENT', 'CASH') NOT NULL DEFAULT 'REGULAR' COMMENT '추가 적립 지갑' AFTER ends_at;
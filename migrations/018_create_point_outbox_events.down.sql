@@ -0,0 +1,2 @@
+-- point_outbox_events 테이블 삭제
+DROP TABLE IF EXISTS point_outbox_events;
@@ -0,0 +1,18 @@
+-- point_outbox_events 테이블 생성 (포인트 거래와 같은 트랜잭션에 저장하는 도메인 이벤트 outbox)
+CREATE TABLE IF NOT EXISTS point_outbox_events (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    event_id CHAR(36) NOT NULL COMMENT '이벤트 고유 ID (구독자 중복 제거용)',
+    event_type VARCHAR(50) NOT NULL COMMENT '이벤트 유형',
+    user_id BIGINT NOT NULL COMMENT '사용자 ID (사용자별 발행 순서 기준)',
+    transaction_id BIGINT NULL COMMENT '이벤트를 만든 거래 ID',
+    payload JSON NOT NULL COMMENT '이벤트 페이로드',
+    status ENUM('PENDING', 'PUBLISHED', 'FAILED') NOT NULL DEFAULT 'PENDING' COMMENT '발행 상태',
+    attempts INT NOT NULL DEFAULT 0 COMMENT '발행 시도 횟수',
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '다음 발행 시도 시각',
+    last_error VARCHAR(500) NULL COMMENT '마지막 발행 실패 사유',
+    published_at TIMESTAMP NULL COMMENT '발행 완료 시각',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_event_id (event_id),
+    INDEX idx_status_next_attempt (status, next_attempt_at),
+    INDEX idx_user_status (user_id, status, id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='포인트 도메인 이벤트 outbox';
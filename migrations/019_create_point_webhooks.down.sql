@@ -0,0 +1,5 @@
+-- point_webhook_deliveries 테이블 삭제
+DROP TABLE IF EXISTS point_webhook_deliveries;
+
+-- point_webhook_subscriptions 테이블 삭제
+DROP TABLE IF EXISTS point_webhook_subscriptions;
@@ -0,0 +1,31 @@
+-- point_webhook_subscriptions 테이블 생성 (포인트 이벤트 웹훅 구독)
+CREATE TABLE IF NOT EXISTS point_webhook_subscriptions (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    url VARCHAR(2048) NOT NULL COMMENT '수신 URL',
+    event_types JSON NULL COMMENT '구독 이벤트 유형 (NULL 이면 전체)',
+    secret VARCHAR(128) NOT NULL COMMENT '서명 비밀키 (HMAC-SHA256)',
+    description VARCHAR(255) NOT NULL DEFAULT '' COMMENT '설명',
+    active BOOLEAN NOT NULL DEFAULT TRUE COMMENT '활성 여부',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_active (active)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='포인트 이벤트 웹훅 구독';
+
+-- point_webhook_deliveries 테이블 생성 (구독별 이벤트 전송 내역, 재시도/dead letter 포함)
+CREATE TABLE IF NOT EXISTS point_webhook_deliveries (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    subscription_id BIGINT NOT NULL COMMENT '웹훅 구독 ID',
+    event_id CHAR(36) NOT NULL COMMENT '이벤트 고유 ID',
+    event_type VARCHAR(50) NOT NULL COMMENT '이벤트 유형',
+    payload JSON NOT NULL COMMENT '이벤트 페이로드',
+    status ENUM('PENDING', 'DELIVERED', 'DEAD') NOT NULL DEFAULT 'PENDING' COMMENT '전송 상태',
+    attempts INT NOT NULL DEFAULT 0 COMMENT '전송 시도 횟수',
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '다음 전송 시도 시각',
+    last_status_code INT NOT NULL DEFAULT 0 COMMENT '마지막 응답 상태 코드 (응답 없으면 0)',
+    last_error VARCHAR(500) NULL COMMENT '마지막 전송 실패 사유',
+    delivered_at TIMESTAMP NULL COMMENT '전송 완료 시각',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_subscription_event (subscription_id, event_id),
+    INDEX idx_status_next_attempt (status, next_attempt_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='웹훅 전송 내역';
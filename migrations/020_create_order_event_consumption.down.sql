@@ -0,0 +1,8 @@
+-- order_event_attempts 테이블 삭제
+DROP TABLE IF EXISTS order_event_attempts;
+
+-- parked_order_events 테이블 삭제
+DROP TABLE IF EXISTS parked_order_events;
+
+-- consumed_order_events 테이블 삭제
+DROP TABLE IF EXISTS consumed_order_events;
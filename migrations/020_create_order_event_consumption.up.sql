@@ -0,0 +1,33 @@
+-- consumed_order_events 테이블 생성 (처리한 주문 수명주기 이벤트, 이벤트 ID 중복 제거용)
+CREATE TABLE IF NOT EXISTS consumed_order_events (
+    event_id VARCHAR(100) NOT NULL PRIMARY KEY COMMENT '주문 이벤트 고유 ID',
+    event_type VARCHAR(50) NOT NULL COMMENT '주문 이벤트 유형',
+    order_id BIGINT NOT NULL COMMENT '주문 ID',
+    user_id BIGINT NOT NULL COMMENT '사용자 ID',
+    occurred_at TIMESTAMP NULL COMMENT '주문 서비스에서 이벤트가 발생한 시각',
+    processed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '처리 시각',
+    INDEX idx_order_id (order_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='처리한 주문 이벤트';
+
+-- parked_order_events 테이블 생성 (처리할 수 없는 주문 이벤트 메시지 보관)
+CREATE TABLE IF NOT EXISTS parked_order_events (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    message_id VARCHAR(255) NOT NULL COMMENT '메시지 소스의 메시지 ID',
+    event_id VARCHAR(100) NULL COMMENT '주문 이벤트 ID (해석 실패 시 NULL)',
+    event_type VARCHAR(50) NULL COMMENT '주문 이벤트 유형 (해석 실패 시 NULL)',
+    body MEDIUMTEXT NOT NULL COMMENT '원본 메시지',
+    reason VARCHAR(500) NOT NULL COMMENT '보관 사유 (마지막 처리 오류)',
+    attempts INT NOT NULL DEFAULT 0 COMMENT '처리 시도 횟수',
+    parked_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '보관 시각',
+    UNIQUE KEY uk_message_id (message_id),
+    INDEX idx_event_id (event_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='보관한 주문 이벤트 메시지';
+
+-- order_event_attempts 테이블 생성 (일시적 오류로 처리에 실패한 주문 이벤트 메시지의 시도 횟수와 다음 시도 시각)
+CREATE TABLE IF NOT EXISTS order_event_attempts (
+    message_id VARCHAR(255) NOT NULL PRIMARY KEY COMMENT '메시지 소스의 메시지 ID',
+    attempts INT NOT NULL DEFAULT 0 COMMENT '실패한 처리 시도 횟수',
+    next_attempt_at TIMESTAMP NOT NULL COMMENT '다음 처리 시도 시각',
+    last_error VARCHAR(500) NOT NULL DEFAULT '' COMMENT '마지막 처리 오류',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '수정 시각'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='주문 이벤트 메시지 처리 시도 기록';